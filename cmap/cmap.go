@@ -1,5 +1,10 @@
 package cmap
 
+import (
+	"cmp"
+	"slices"
+)
+
 // Map describes the common operations for a generic concurrent-safe map.
 type Map[K comparable, V any] interface {
 	// Load retrieves the value for key, returning ok=false if the key is absent.
@@ -8,12 +13,68 @@ type Map[K comparable, V any] interface {
 	Store(key K, value V)
 	// LoadOrStore returns the existing value if present; otherwise, it stores and returns the given value.
 	LoadOrStore(key K, value V) (actual V, loaded bool)
+	// StoreIfAbsent stores value only if key is absent, reporting whether it was inserted.
+	StoreIfAbsent(key K, value V) (inserted bool)
+	// StoreIfPresent replaces the value only if key is present, returning the previous value
+	// and whether it was replaced.
+	// It never compares values, so it works for any V, including NaN and non-comparable types.
+	StoreIfPresent(key K, value V) (previous V, replaced bool)
+	// LoadOrCompute returns the existing value if present; otherwise, it calls fn and stores
+	// the result. fn is only invoked when the key is absent.
+	LoadOrCompute(key K, fn func() V) (actual V, loaded bool)
+	// LoadOrTryCompute returns the existing value if present; otherwise, it calls compute and stores
+	// the result. If compute returns an error, nothing is stored and the error is returned.
+	LoadOrTryCompute(key K, compute func() (V, error)) (actual V, loaded bool, err error)
+	// Compute atomically replaces the value for key with the result of fn, which receives the
+	// current value and whether it was present. If fn returns delete=true the key is removed instead.
+	// It returns the resulting value and whether the key is present afterwards.
+	Compute(key K, fn func(old V, loaded bool) (newValue V, delete bool)) (actual V, ok bool)
+	// CompareAndSwap replaces the value for key with new only if the current value equals old.
+	// As with sync.Map, values are compared with ==, so V must be comparable at runtime or the
+	// call panics; use Compute with a custom equality check for non-comparable values.
+	CompareAndSwap(key K, old, new V) (swapped bool)
+	// CompareAndDelete removes key only if its current value equals old, with the same
+	// comparability requirement as CompareAndSwap.
+	CompareAndDelete(key K, old V) (deleted bool)
 	// LoadAndDelete removes the key and returns its previous value if it existed.
 	LoadAndDelete(key K) (value V, loaded bool)
 	// Delete removes the key without returning the previous value.
 	Delete(key K)
+	// StoreAll stores every entry of entries, replacing existing values.
+	StoreAll(entries map[K]V)
+	// DeleteAll removes every key in keys; absent keys are ignored.
+	DeleteAll(keys []K)
 	// Range iterates over all key/value pairs until the provided function returns false.
 	Range(func(key K, value V) bool)
+	// Keys returns a snapshot of all keys in unspecified order.
+	Keys() []K
+	// Values returns a snapshot of all values in unspecified order.
+	Values() []V
+	// ToMap returns a fresh built-in map holding a snapshot of all entries; later changes
+	// to either map do not affect the other.
+	ToMap() map[K]V
 	// Len reports the number of key/value pairs currently in the map.
 	Len() int
 }
+
+// RangeSorted calls fn for each key/value pair of m in ascending key order until fn returns false.
+// It takes a point-in-time snapshot via m.Range, sorts it by key, then iterates the snapshot
+// without holding any lock, so fn may modify m; such changes are not reflected in the iteration.
+func RangeSorted[K cmp.Ordered, V any](m Map[K, V], fn func(key K, value V) bool) {
+	type entry struct {
+		key K
+		val V
+	}
+	var snapshot []entry
+	m.Range(func(k K, v V) bool {
+		snapshot = append(snapshot, entry{k, v})
+		return true
+	})
+	slices.SortFunc(snapshot, func(a, b entry) int { return cmp.Compare(a.key, b.key) })
+
+	for _, item := range snapshot {
+		if !fn(item.key, item.val) {
+			return
+		}
+	}
+}
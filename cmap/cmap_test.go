@@ -5,7 +5,9 @@ import (
 	"testing"
 
 	"github.com/kwstars/gx/cmap"
+	"github.com/kwstars/gx/cmap/lrumap"
 	"github.com/kwstars/gx/cmap/rwmap"
+	"github.com/kwstars/gx/cmap/shardmap"
 	"github.com/kwstars/gx/cmap/syncmap"
 )
 
@@ -29,6 +31,90 @@ var benchFactories = []struct {
 			return syncmap.New[int, int]()
 		},
 	},
+	{
+		name: "shardmap",
+		factory: func() cmap.Map[int, int] {
+			return shardmap.New[int, int]()
+		},
+	},
+	{
+		name: "lrumap",
+		factory: func() cmap.Map[int, int] {
+			// Sized to the key space so benchmarks measure overhead, not eviction.
+			return lrumap.New[int, int](benchKeySpace)
+		},
+	},
+}
+
+func TestRangeSorted(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range benchFactories {
+		t.Run(tc.name, func(t *testing.T) {
+			m := tc.factory()
+			for _, k := range []int{42, 7, -3, 19, 0} {
+				m.Store(k, k*10)
+			}
+
+			var keys []int
+			cmap.RangeSorted(m, func(key, value int) bool {
+				if value != key*10 {
+					t.Fatalf("expected value %d for key %d, got %d", key*10, key, value)
+				}
+				keys = append(keys, key)
+				return true
+			})
+
+			want := []int{-3, 0, 7, 19, 42}
+			if len(keys) != len(want) {
+				t.Fatalf("expected keys %v, got %v", want, keys)
+			}
+			for i := range want {
+				if keys[i] != want[i] {
+					t.Fatalf("expected keys %v, got %v", want, keys)
+				}
+			}
+
+			var visited []int
+			cmap.RangeSorted(m, func(key, _ int) bool {
+				visited = append(visited, key)
+				m.Delete(key)
+				return len(visited) < 2
+			})
+			if len(visited) != 2 || visited[0] != -3 || visited[1] != 0 {
+				t.Fatalf("expected early stop after [-3 0], got %v", visited)
+			}
+		})
+	}
+}
+
+func TestToMap(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range benchFactories {
+		t.Run(tc.name, func(t *testing.T) {
+			m := tc.factory()
+			if got := m.ToMap(); got == nil || len(got) != 0 {
+				t.Fatalf("expected empty non-nil map, got %v", got)
+			}
+
+			m.Store(1, 10)
+			m.Store(2, 20)
+			snapshot := m.ToMap()
+			if len(snapshot) != 2 || snapshot[1] != 10 || snapshot[2] != 20 {
+				t.Fatalf("expected map[1:10 2:20], got %v", snapshot)
+			}
+
+			snapshot[3] = 30
+			m.Store(1, 100)
+			if _, ok := m.Load(3); ok {
+				t.Fatalf("expected snapshot writes not to reach the map")
+			}
+			if snapshot[1] != 10 {
+				t.Fatalf("expected map writes not to reach the snapshot, got %d", snapshot[1])
+			}
+		})
+	}
 }
 
 func BenchmarkMapStore(b *testing.B) {
@@ -79,6 +165,32 @@ func BenchmarkMapLen(b *testing.B) {
 	}
 }
 
+func BenchmarkMapStoreAll(b *testing.B) {
+	entries := make(map[int]int, benchKeySpace)
+	for i := 0; i < benchKeySpace; i++ {
+		entries[i] = i
+	}
+
+	for _, tc := range benchFactories {
+		b.Run(tc.name+"/bulk", func(b *testing.B) {
+			m := tc.factory()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				m.StoreAll(entries)
+			}
+		})
+		b.Run(tc.name+"/individual", func(b *testing.B) {
+			m := tc.factory()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				for k, v := range entries {
+					m.Store(k, v)
+				}
+			}
+		})
+	}
+}
+
 func benchmarkStore(b *testing.B, factory func() cmap.Map[int, int]) {
 	b.Helper()
 	m := factory()
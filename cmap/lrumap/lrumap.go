@@ -0,0 +1,352 @@
+package lrumap
+
+import (
+	"sync"
+
+	"github.com/kwstars/gx/cmap"
+)
+
+// entry is a node of the recency list.
+type entry[K comparable, V any] struct {
+	key        K
+	value      V
+	prev, next *entry[K, V]
+}
+
+// lruMap implements cmap.Map with a fixed capacity, evicting the least recently used
+// entry when a new key is inserted into a full map.
+// Entries are kept in a doubly linked list ordered from most to least recently used,
+// indexed by a built-in map. Because reads reorder the list, every method takes the
+// same sync.Mutex; there is no read-only fast path.
+// All methods assume m != nil, consistent with rwmap.
+type lruMap[K comparable, V any] struct {
+	mu         sync.Mutex
+	maxEntries int
+	items      map[K]*entry[K, V] // Guaranteed non-nil by newMap()
+	root       entry[K, V]        // Sentinel: root.next is the most recent, root.prev the least
+}
+
+// Ensure lruMap obeys cmap.Map interface at compile time.
+var _ cmap.Map[int, int] = (*lruMap[int, int])(nil)
+
+// New returns a cmap.Map holding at most maxEntries entries, evicting the least
+// recently used entry when a new key is added to a full map.
+// Load, updates of existing keys and successful conditional writes count as a use.
+// New panics if maxEntries is not positive.
+func New[K comparable, V any](maxEntries int) cmap.Map[K, V] {
+	return newMap[K, V](maxEntries)
+}
+
+// newMap exposes concrete type for callers needing assertions in tests.
+func newMap[K comparable, V any](maxEntries int) *lruMap[K, V] {
+	if maxEntries <= 0 {
+		panic("lrumap: maxEntries must be positive")
+	}
+	m := &lruMap[K, V]{
+		maxEntries: maxEntries,
+		items:      make(map[K]*entry[K, V]), // Always initialized; never nil
+	}
+	m.root.next = &m.root
+	m.root.prev = &m.root
+	return m
+}
+
+// unlink removes e from the recency list.
+func (m *lruMap[K, V]) unlink(e *entry[K, V]) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.prev, e.next = nil, nil
+}
+
+// pushFront inserts e as the most recently used entry.
+func (m *lruMap[K, V]) pushFront(e *entry[K, V]) {
+	e.prev = &m.root
+	e.next = m.root.next
+	m.root.next.prev = e
+	m.root.next = e
+}
+
+// touch marks e as the most recently used entry.
+func (m *lruMap[K, V]) touch(e *entry[K, V]) {
+	if m.root.next == e {
+		return
+	}
+	m.unlink(e)
+	m.pushFront(e)
+}
+
+// set stores value for key, marking it most recently used and evicting the least
+// recently used entry if a new key overflows the capacity. Callers must hold mu.
+func (m *lruMap[K, V]) set(key K, value V) {
+	if e, ok := m.items[key]; ok {
+		e.value = value
+		m.touch(e)
+		return
+	}
+	if len(m.items) >= m.maxEntries {
+		oldest := m.root.prev
+		m.unlink(oldest)
+		delete(m.items, oldest.key)
+	}
+	e := &entry[K, V]{key: key, value: value}
+	m.pushFront(e)
+	m.items[key] = e
+}
+
+// remove deletes e from both the list and the index. Callers must hold mu.
+func (m *lruMap[K, V]) remove(e *entry[K, V]) {
+	m.unlink(e)
+	delete(m.items, e.key)
+}
+
+// Load retrieves the value for key and marks it most recently used.
+func (m *lruMap[K, V]) Load(key K) (value V, ok bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.items[key]
+	if !ok {
+		return value, false
+	}
+	m.touch(e)
+	return e.value, true
+}
+
+// Store sets the value for key, evicting the least recently used entry if the map is full.
+func (m *lruMap[K, V]) Store(key K, value V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.set(key, value)
+}
+
+// LoadOrStore returns the existing value if present, storing otherwise.
+func (m *lruMap[K, V]) LoadOrStore(key K, value V) (actual V, loaded bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if e, ok := m.items[key]; ok {
+		m.touch(e)
+		return e.value, true
+	}
+	m.set(key, value)
+	return value, false
+}
+
+// StoreIfAbsent stores value only if key is absent, reporting whether it was inserted.
+func (m *lruMap[K, V]) StoreIfAbsent(key K, value V) (inserted bool) {
+	_, loaded := m.LoadOrStore(key, value)
+	return !loaded
+}
+
+// StoreIfPresent replaces the value only if key is present.
+// Returns the previous value and true if replaced, or the zero value and false if absent.
+func (m *lruMap[K, V]) StoreIfPresent(key K, value V) (previous V, replaced bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.items[key]
+	if !ok {
+		return previous, false
+	}
+	previous = e.value
+	e.value = value
+	m.touch(e)
+	return previous, true
+}
+
+// LoadOrCompute returns the existing value if present; otherwise it runs fn and stores
+// its result. fn runs under the lock, so it runs at most once per absent key and must
+// not call back into the map.
+func (m *lruMap[K, V]) LoadOrCompute(key K, fn func() V) (actual V, loaded bool) {
+	actual, loaded, _ = m.LoadOrTryCompute(key, func() (V, error) { return fn(), nil })
+	return actual, loaded
+}
+
+// LoadOrTryCompute returns the existing value if present; otherwise it runs compute
+// and stores its result. A compute error is returned and nothing is stored.
+// compute runs under the lock and must not call back into the map.
+func (m *lruMap[K, V]) LoadOrTryCompute(key K, compute func() (V, error)) (actual V, loaded bool, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if e, ok := m.items[key]; ok {
+		m.touch(e)
+		return e.value, true, nil
+	}
+	value, err := compute()
+	if err != nil {
+		var zero V
+		return zero, false, err
+	}
+	m.set(key, value)
+	return value, false, nil
+}
+
+// Compute atomically updates the value for key using fn under the lock.
+// fn runs exactly once while the lock is held, so it must not call back into the map.
+func (m *lruMap[K, V]) Compute(key K, fn func(old V, loaded bool) (newValue V, delete bool)) (actual V, ok bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var old V
+	e, loaded := m.items[key]
+	if loaded {
+		old = e.value
+	}
+	newValue, del := fn(old, loaded)
+	if del {
+		if loaded {
+			m.remove(e)
+		}
+		var zero V
+		return zero, false
+	}
+	m.set(key, newValue)
+	return newValue, true
+}
+
+// CompareAndSwap replaces the value for key with new if the current value equals old.
+// Values are compared with == and panic if not comparable.
+func (m *lruMap[K, V]) CompareAndSwap(key K, old, new V) (swapped bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.items[key]
+	if !ok || any(e.value) != any(old) {
+		return false
+	}
+	e.value = new
+	m.touch(e)
+	return true
+}
+
+// CompareAndDelete removes key if its current value equals old.
+// Values are compared with == and panic if not comparable.
+func (m *lruMap[K, V]) CompareAndDelete(key K, old V) (deleted bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.items[key]
+	if !ok || any(e.value) != any(old) {
+		return false
+	}
+	m.remove(e)
+	return true
+}
+
+// LoadAndDelete removes key and returns prior value if it existed.
+func (m *lruMap[K, V]) LoadAndDelete(key K) (value V, loaded bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.items[key]
+	if !ok {
+		return value, false
+	}
+	m.remove(e)
+	return e.value, true
+}
+
+// Delete removes the key without reporting previous value.
+func (m *lruMap[K, V]) Delete(key K) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if e, ok := m.items[key]; ok {
+		m.remove(e)
+	}
+}
+
+// StoreAll stores every entry under a single lock acquisition.
+// If entries holds more keys than the capacity, earlier ones (in map iteration
+// order) are evicted by later ones.
+func (m *lruMap[K, V]) StoreAll(entries map[K]V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for k, v := range entries {
+		m.set(k, v)
+	}
+}
+
+// DeleteAll removes every key under a single lock acquisition.
+func (m *lruMap[K, V]) DeleteAll(keys []K) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, k := range keys {
+		if e, ok := m.items[k]; ok {
+			m.remove(e)
+		}
+	}
+}
+
+// Range iterates over a snapshot of the entries, from most to least recently used,
+// until fn returns false. Iterating does not count as a use, and the lock is held
+// only while the snapshot is taken, so fn may call back into the map.
+func (m *lruMap[K, V]) Range(fn func(key K, value V) bool) {
+	if fn == nil {
+		return
+	}
+
+	m.mu.Lock()
+	snapshot := make([]struct {
+		key K
+		val V
+	}, 0, len(m.items))
+	for e := m.root.next; e != &m.root; e = e.next {
+		snapshot = append(snapshot, struct {
+			key K
+			val V
+		}{e.key, e.value})
+	}
+	m.mu.Unlock()
+
+	for _, item := range snapshot {
+		if !fn(item.key, item.val) {
+			return
+		}
+	}
+}
+
+// Keys returns a snapshot of the keys from most to least recently used.
+func (m *lruMap[K, V]) Keys() []K {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	keys := make([]K, 0, len(m.items))
+	for e := m.root.next; e != &m.root; e = e.next {
+		keys = append(keys, e.key)
+	}
+	return keys
+}
+
+// Values returns a snapshot of the values from most to least recently used.
+func (m *lruMap[K, V]) Values() []V {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	values := make([]V, 0, len(m.items))
+	for e := m.root.next; e != &m.root; e = e.next {
+		values = append(values, e.value)
+	}
+	return values
+}
+
+// ToMap returns a copy of all entries taken under the lock, disconnected from the map.
+// Copying does not count as a use of the entries.
+func (m *lruMap[K, V]) ToMap() map[K]V {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	result := make(map[K]V, len(m.items))
+	for k, e := range m.items {
+		result[k] = e.value
+	}
+	return result
+}
+
+// Len reports the number of key/value pairs in the map, never more than maxEntries.
+func (m *lruMap[K, V]) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.items)
+}
@@ -0,0 +1,181 @@
+package lrumap
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func keysOf(m *lruMap[string, int]) string {
+	s := ""
+	for _, k := range m.Keys() {
+		s += k
+	}
+	return s
+}
+
+func TestLRUMapEviction(t *testing.T) {
+	t.Parallel()
+
+	m := newMap[string, int](3)
+	m.Store("a", 1)
+	m.Store("b", 2)
+	m.Store("c", 3)
+	if got := keysOf(m); got != "cba" {
+		t.Fatalf("expected recency order cba, got %s", got)
+	}
+
+	// Loading a makes b the least recently used entry.
+	if got, ok := m.Load("a"); !ok || got != 1 {
+		t.Fatalf("expected a=1, got %v ok=%v", got, ok)
+	}
+	m.Store("d", 4)
+	if _, ok := m.Load("b"); ok {
+		t.Fatalf("expected b to be evicted")
+	}
+	if gotLen := m.Len(); gotLen != 3 {
+		t.Fatalf("expected len=3 at capacity, got %d", gotLen)
+	}
+
+	// Overwriting an existing key never evicts.
+	m.Store("c", 30)
+	if gotLen := m.Len(); gotLen != 3 {
+		t.Fatalf("expected len=3 after overwrite, got %d", gotLen)
+	}
+	if got := keysOf(m); got != "cda" {
+		t.Fatalf("expected recency order cda, got %s", got)
+	}
+}
+
+func TestLRUMapSingleEntry(t *testing.T) {
+	t.Parallel()
+
+	m := New[int, int](1)
+	for i := 0; i < 10; i++ {
+		m.Store(i, i)
+	}
+	if gotLen := m.Len(); gotLen != 1 {
+		t.Fatalf("expected len=1, got %d", gotLen)
+	}
+	if got, ok := m.Load(9); !ok || got != 9 {
+		t.Fatalf("expected only the latest entry, got %v ok=%v", got, ok)
+	}
+}
+
+func TestLRUMapInvalidCapacity(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("expected panic for non-positive maxEntries")
+		}
+	}()
+	New[int, int](0)
+}
+
+func TestLRUMapOperations(t *testing.T) {
+	t.Parallel()
+
+	m := newMap[string, int](2)
+
+	if actual, loaded := m.LoadOrStore("a", 1); loaded || actual != 1 {
+		t.Fatalf("expected insert, got %d loaded=%v", actual, loaded)
+	}
+	if !m.StoreIfAbsent("b", 2) || m.StoreIfAbsent("b", 20) {
+		t.Fatalf("expected StoreIfAbsent to insert once")
+	}
+	if prev, replaced := m.StoreIfPresent("a", 10); !replaced || prev != 1 {
+		t.Fatalf("expected replace of 1, got %d replaced=%v", prev, replaced)
+	}
+
+	// Inserting through Compute evicts the least recently used entry (b).
+	m.Compute("c", func(old int, loaded bool) (int, bool) { return old + 3, false })
+	if _, ok := m.Load("b"); ok {
+		t.Fatalf("expected b to be evicted by Compute insert")
+	}
+
+	errBackend := errors.New("backend unavailable")
+	if _, _, err := m.LoadOrTryCompute("d", func() (int, error) { return 0, errBackend }); err != errBackend {
+		t.Fatalf("expected compute error, got %v", err)
+	}
+	if gotLen := m.Len(); gotLen != 2 {
+		t.Fatalf("expected failed compute not to evict, got len=%d", gotLen)
+	}
+
+	if !m.CompareAndSwap("c", 3, 4) || m.CompareAndSwap("c", 3, 5) {
+		t.Fatalf("expected CompareAndSwap to succeed only with the current value")
+	}
+	if !m.CompareAndDelete("c", 4) {
+		t.Fatalf("expected CompareAndDelete to succeed")
+	}
+	if val, loaded := m.LoadAndDelete("a"); !loaded || val != 10 {
+		t.Fatalf("expected delete of a=10, got %d loaded=%v", val, loaded)
+	}
+	if gotLen := m.Len(); gotLen != 0 {
+		t.Fatalf("expected empty map, got len=%d", gotLen)
+	}
+
+	m.StoreAll(map[string]int{"x": 1, "y": 2, "z": 3})
+	if gotLen := m.Len(); gotLen != 2 {
+		t.Fatalf("expected StoreAll to respect capacity, got len=%d", gotLen)
+	}
+	m.DeleteAll(m.Keys())
+	if gotLen := m.Len(); gotLen != 0 {
+		t.Fatalf("expected DeleteAll to empty the map, got len=%d", gotLen)
+	}
+
+	// The list must stay consistent after removals.
+	m.Store("p", 1)
+	m.Store("q", 2)
+	m.Delete("p")
+	m.Store("r", 3)
+	if got := keysOf(m); got != "rq" {
+		t.Fatalf("expected recency order rq, got %s", got)
+	}
+}
+
+func TestLRUMapRange(t *testing.T) {
+	t.Parallel()
+
+	m := newMap[string, int](3)
+	m.Store("a", 1)
+	m.Store("b", 2)
+	m.Store("c", 3)
+
+	var order string
+	m.Range(func(k string, _ int) bool {
+		order += k
+		m.Load(k) // Callbacks may use the map; Range itself does not reorder.
+		return true
+	})
+	if order != "cba" {
+		t.Fatalf("expected range in recency order cba, got %s", order)
+	}
+
+	values := m.Values()
+	if len(values) != 3 || values[0] != 1 {
+		t.Fatalf("expected values in recency order starting with 1, got %v", values)
+	}
+}
+
+func TestLRUMapConcurrentAccess(t *testing.T) {
+	t.Parallel()
+
+	m := New[int, int](64)
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 500; j++ {
+				m.Store(i*1000+j, j)
+				m.Load(i*1000 + j/2)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if gotLen := m.Len(); gotLen != 64 {
+		t.Fatalf("expected len=64 at capacity, got %d", gotLen)
+	}
+}
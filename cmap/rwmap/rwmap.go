@@ -22,6 +22,18 @@ func New[K comparable, V any]() cmap.Map[K, V] {
 	return newMap[K, V]()
 }
 
+// FromMap returns a cmap.Map backed by RWMutex and populated with the entries of src.
+// Entries are copied, so src may be modified afterwards without affecting the result.
+func FromMap[K comparable, V any](src map[K]V) cmap.Map[K, V] {
+	m := &rwMap[K, V]{
+		store: make(map[K]V, len(src)),
+	}
+	for k, v := range src {
+		m.store[k] = v
+	}
+	return m
+}
+
 // newMap exposes concrete type for callers needing assertions in tests.
 func newMap[K comparable, V any]() *rwMap[K, V] {
 	return &rwMap[K, V]{
@@ -57,6 +69,107 @@ func (m *rwMap[K, V]) LoadOrStore(key K, value V) (actual V, loaded bool) {
 	return value, false
 }
 
+// StoreIfAbsent stores value only if key is absent, reporting whether it was inserted.
+func (m *rwMap[K, V]) StoreIfAbsent(key K, value V) (inserted bool) {
+	_, loaded := m.LoadOrStore(key, value)
+	return !loaded
+}
+
+// StoreIfPresent replaces the value only if key is present, under the write lock.
+// Returns the previous value and true if replaced, or the zero value and false if absent.
+func (m *rwMap[K, V]) StoreIfPresent(key K, value V) (previous V, replaced bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	previous, replaced = m.store[key]
+	if replaced {
+		m.store[key] = value
+	}
+	return previous, replaced
+}
+
+// LoadOrCompute returns the existing value if present; otherwise it runs fn and stores
+// its result. The hit path only takes the read lock; on a miss the write lock is taken
+// and the key re-checked, so fn runs at most once per absent key (single-flight) and
+// must not call back into the map.
+func (m *rwMap[K, V]) LoadOrCompute(key K, fn func() V) (actual V, loaded bool) {
+	actual, loaded, _ = m.LoadOrTryCompute(key, func() (V, error) { return fn(), nil })
+	return actual, loaded
+}
+
+// LoadOrTryCompute returns the existing value if present; otherwise it runs compute
+// and stores its result. A compute error is returned and nothing is stored.
+// The hit path only takes the read lock; on a miss compute runs under the write lock,
+// so it is invoked at most once per absent key and must not call back into the map.
+func (m *rwMap[K, V]) LoadOrTryCompute(key K, compute func() (V, error)) (actual V, loaded bool, err error) {
+	m.mu.RLock()
+	existing, ok := m.store[key]
+	m.mu.RUnlock()
+	if ok {
+		return existing, true, nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// Double-check: another writer may have stored the key in between.
+	if existing, ok := m.store[key]; ok {
+		return existing, true, nil
+	}
+	value, err := compute()
+	if err != nil {
+		var zero V
+		return zero, false, err
+	}
+	m.store[key] = value
+	return value, false, nil
+}
+
+// Compute atomically updates the value for key using fn under the write lock.
+// fn runs exactly once while the lock is held, so it must not call back into the map.
+func (m *rwMap[K, V]) Compute(key K, fn func(old V, loaded bool) (newValue V, delete bool)) (actual V, ok bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	old, loaded := m.store[key]
+	newValue, del := fn(old, loaded)
+	if del {
+		delete(m.store, key)
+		var zero V
+		return zero, false
+	}
+	m.store[key] = newValue
+	return newValue, true
+}
+
+// CompareAndSwap replaces the value for key with new if the current value equals old.
+// Runs under the write lock; values are compared with == and panic if not comparable.
+func (m *rwMap[K, V]) CompareAndSwap(key K, old, new V) (swapped bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	current, ok := m.store[key]
+	if !ok || any(current) != any(old) {
+		return false
+	}
+	m.store[key] = new
+	return true
+}
+
+// CompareAndDelete removes key if its current value equals old.
+// Runs under the write lock; values are compared with == and panic if not comparable.
+func (m *rwMap[K, V]) CompareAndDelete(key K, old V) (deleted bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	current, ok := m.store[key]
+	if !ok || any(current) != any(old) {
+		return false
+	}
+	delete(m.store, key)
+	return true
+}
+
 // LoadAndDelete removes key and returns prior value if it existed.
 func (m *rwMap[K, V]) LoadAndDelete(key K) (value V, loaded bool) {
 	m.mu.Lock()
@@ -76,6 +189,25 @@ func (m *rwMap[K, V]) Delete(key K) {
 	delete(m.store, key)
 }
 
+// StoreAll stores every entry under a single write-lock acquisition, which is much
+// cheaper than calling Store for each entry when bulk-loading.
+func (m *rwMap[K, V]) StoreAll(entries map[K]V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for k, v := range entries {
+		m.store[k] = v
+	}
+}
+
+// DeleteAll removes every key under a single write-lock acquisition.
+func (m *rwMap[K, V]) DeleteAll(keys []K) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, k := range keys {
+		delete(m.store, k)
+	}
+}
+
 // Range iterates over a snapshot of the map entries until fn returns false.
 // Snapshot semantics:
 //   - Entries seen are those present when Range acquired the read lock
@@ -108,6 +240,43 @@ func (m *rwMap[K, V]) Range(fn func(key K, value V) bool) {
 	}
 }
 
+// Keys returns the keys present when the read lock was acquired, in unspecified order.
+func (m *rwMap[K, V]) Keys() []K {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	keys := make([]K, 0, len(m.store))
+	for k := range m.store {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Values returns the values present when the read lock was acquired, in unspecified order.
+func (m *rwMap[K, V]) Values() []V {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	values := make([]V, 0, len(m.store))
+	for _, v := range m.store {
+		values = append(values, v)
+	}
+	return values
+}
+
+// ToMap returns a copy of all entries taken under a single read lock.
+// The result is a point-in-time snapshot disconnected from the map.
+func (m *rwMap[K, V]) ToMap() map[K]V {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	result := make(map[K]V, len(m.store))
+	for k, v := range m.store {
+		result[k] = v
+	}
+	return result
+}
+
 // Len reports the number of key/value pairs in the map.
 func (m *rwMap[K, V]) Len() int {
 	m.mu.RLock()
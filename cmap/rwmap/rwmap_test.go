@@ -1,7 +1,10 @@
 package rwmap
 
 import (
+	"errors"
+	"sort"
 	"sync"
+	"sync/atomic"
 	"testing"
 )
 
@@ -126,3 +129,273 @@ func TestRWMapConcurrentAccess(t *testing.T) {
 		t.Fatalf("expected len=%d after concurrent writes, got %d", total, got)
 	}
 }
+
+func TestRWMapFromMap(t *testing.T) {
+	t.Parallel()
+
+	src := map[string]int{"a": 1, "b": 2}
+	m := FromMap(src)
+
+	src["a"] = 100
+	src["c"] = 3
+
+	if gotLen := m.Len(); gotLen != 2 {
+		t.Fatalf("expected len=2, got %d", gotLen)
+	}
+	if got, ok := m.Load("a"); !ok || got != 1 {
+		t.Fatalf("expected a=1 unaffected by source mutation, got %v ok=%v", got, ok)
+	}
+	if _, ok := m.Load("c"); ok {
+		t.Fatalf("expected c to be absent")
+	}
+}
+
+func TestRWMapLoadOrTryCompute(t *testing.T) {
+	t.Parallel()
+
+	m := New[string, int]()
+	errBackend := errors.New("backend unavailable")
+
+	if _, loaded, err := m.LoadOrTryCompute("a", func() (int, error) { return 0, errBackend }); err != errBackend || loaded {
+		t.Fatalf("expected compute error and loaded=false, got err=%v loaded=%v", err, loaded)
+	}
+	if _, ok := m.Load("a"); ok {
+		t.Fatalf("expected nothing stored after compute error")
+	}
+
+	if actual, loaded, err := m.LoadOrTryCompute("a", func() (int, error) { return 1, nil }); err != nil || loaded || actual != 1 {
+		t.Fatalf("expected computed value 1, got %d loaded=%v err=%v", actual, loaded, err)
+	}
+
+	calls := 0
+	actual, loaded, err := m.LoadOrTryCompute("a", func() (int, error) { calls++; return 2, nil })
+	if err != nil || !loaded || actual != 1 || calls != 0 {
+		t.Fatalf("expected existing value without compute, got %d loaded=%v err=%v calls=%d", actual, loaded, err, calls)
+	}
+}
+
+func TestRWMapCompute(t *testing.T) {
+	t.Parallel()
+
+	m := New[string, int]()
+	increment := func(old int, _ bool) (int, bool) { return old + 1, false }
+
+	if actual, ok := m.Compute("hits", increment); !ok || actual != 1 {
+		t.Fatalf("expected insert of 1, got %d ok=%v", actual, ok)
+	}
+
+	const workers, perWorker = 16, 200
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < perWorker; j++ {
+				m.Compute("hits", increment)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got, _ := m.Load("hits"); got != 1+workers*perWorker {
+		t.Fatalf("expected %d after concurrent increments, got %d", 1+workers*perWorker, got)
+	}
+
+	remove := func(_ int, loaded bool) (int, bool) { return 0, true }
+	if actual, ok := m.Compute("hits", remove); ok || actual != 0 {
+		t.Fatalf("expected delete, got %d ok=%v", actual, ok)
+	}
+	if _, ok := m.Load("hits"); ok {
+		t.Fatalf("expected key to be removed")
+	}
+
+	var sawLoaded bool
+	m.Compute("missing", func(_ int, loaded bool) (int, bool) { sawLoaded = loaded; return 0, true })
+	if sawLoaded || m.Len() != 0 {
+		t.Fatalf("expected delete of absent key to be a no-op, loaded=%v len=%d", sawLoaded, m.Len())
+	}
+}
+
+func TestRWMapKeysValues(t *testing.T) {
+	t.Parallel()
+
+	m := New[string, int]()
+	if keys, values := m.Keys(), m.Values(); keys == nil || len(keys) != 0 || values == nil || len(values) != 0 {
+		t.Fatalf("expected empty non-nil snapshots, got keys=%v values=%v", keys, values)
+	}
+
+	m.Store("a", 1)
+	m.Store("b", 2)
+	m.Store("c", 3)
+
+	keys := m.Keys()
+	sort.Strings(keys)
+	if len(keys) != 3 || keys[0] != "a" || keys[2] != "c" {
+		t.Fatalf("expected keys [a b c], got %v", keys)
+	}
+
+	values := m.Values()
+	sort.Ints(values)
+	if len(values) != 3 || values[0] != 1 || values[2] != 3 {
+		t.Fatalf("expected values [1 2 3], got %v", values)
+	}
+
+	keys[0] = "mutated"
+	if _, ok := m.Load("mutated"); ok {
+		t.Fatalf("expected snapshot to be independent of the map")
+	}
+}
+
+func TestRWMapStoreIfAbsentIfPresent(t *testing.T) {
+	t.Parallel()
+
+	m := New[string, int]()
+
+	if prev, replaced := m.StoreIfPresent("a", 1); replaced || prev != 0 {
+		t.Fatalf("expected no update for absent key, got %d replaced=%v", prev, replaced)
+	}
+	if _, ok := m.Load("a"); ok {
+		t.Fatalf("expected StoreIfPresent not to insert")
+	}
+
+	if !m.StoreIfAbsent("a", 1) {
+		t.Fatalf("expected insert of absent key")
+	}
+	if m.StoreIfAbsent("a", 2) {
+		t.Fatalf("expected no insert for present key")
+	}
+	if got, _ := m.Load("a"); got != 1 {
+		t.Fatalf("expected a=1 to be kept, got %d", got)
+	}
+
+	if prev, replaced := m.StoreIfPresent("a", 3); !replaced || prev != 1 {
+		t.Fatalf("expected replace of 1, got %d replaced=%v", prev, replaced)
+	}
+	if got, _ := m.Load("a"); got != 3 {
+		t.Fatalf("expected a=3 after refresh, got %d", got)
+	}
+}
+
+func TestRWMapCompareAndSwapDelete(t *testing.T) {
+	t.Parallel()
+
+	m := New[string, int]()
+	if m.CompareAndSwap("a", 0, 1) {
+		t.Fatalf("expected swap of absent key to fail")
+	}
+
+	m.Store("a", 1)
+	if m.CompareAndSwap("a", 2, 3) {
+		t.Fatalf("expected swap with stale old value to fail")
+	}
+	if !m.CompareAndSwap("a", 1, 2) {
+		t.Fatalf("expected swap with current value to succeed")
+	}
+
+	// Optimistic-concurrency counter built from Load and CompareAndSwap.
+	const workers, perWorker = 8, 100
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < perWorker; j++ {
+				for {
+					current, _ := m.Load("a")
+					if m.CompareAndSwap("a", current, current+1) {
+						break
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got, _ := m.Load("a"); got != 2+workers*perWorker {
+		t.Fatalf("expected %d after optimistic increments, got %d", 2+workers*perWorker, got)
+	}
+
+	if m.CompareAndDelete("a", 0) {
+		t.Fatalf("expected delete with stale old value to fail")
+	}
+	if !m.CompareAndDelete("a", 2+workers*perWorker) {
+		t.Fatalf("expected delete with current value to succeed")
+	}
+	if _, ok := m.Load("a"); ok {
+		t.Fatalf("expected key to be removed")
+	}
+}
+
+func TestRWMapCompareAndSwapNonComparable(t *testing.T) {
+	t.Parallel()
+
+	m := New[string, []int]()
+	m.Store("a", []int{1})
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("expected panic for non-comparable values")
+		}
+	}()
+	m.CompareAndSwap("a", []int{1}, []int{2})
+}
+
+func TestRWMapStoreAllDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	m := New[int, string]()
+	m.Store(1, "old")
+
+	m.StoreAll(map[int]string{1: "a", 2: "b", 3: "c"})
+	if gotLen := m.Len(); gotLen != 3 {
+		t.Fatalf("expected len=3 after StoreAll, got %d", gotLen)
+	}
+	if got, _ := m.Load(1); got != "a" {
+		t.Fatalf("expected StoreAll to overwrite 1, got %q", got)
+	}
+
+	m.StoreAll(nil)
+	m.DeleteAll([]int{1, 3, 99})
+	if gotLen := m.Len(); gotLen != 1 {
+		t.Fatalf("expected len=1 after DeleteAll, got %d", gotLen)
+	}
+	if got, ok := m.Load(2); !ok || got != "b" {
+		t.Fatalf("expected 2=b to remain, got %q ok=%v", got, ok)
+	}
+}
+
+func TestRWMapLoadOrCompute(t *testing.T) {
+	t.Parallel()
+
+	m := New[string, int]()
+	calls := 0
+	build := func() int { calls++; return 42 }
+
+	if actual, loaded := m.LoadOrCompute("conn", build); loaded || actual != 42 || calls != 1 {
+		t.Fatalf("expected computed 42 on miss, got %d loaded=%v calls=%d", actual, loaded, calls)
+	}
+	if actual, loaded := m.LoadOrCompute("conn", build); !loaded || actual != 42 || calls != 1 {
+		t.Fatalf("expected cached 42 without compute, got %d loaded=%v calls=%d", actual, loaded, calls)
+	}
+}
+
+func TestRWMapLoadOrComputeSingleFlight(t *testing.T) {
+	t.Parallel()
+
+	m := New[string, int]()
+	var calls atomic.Int32
+
+	var wg sync.WaitGroup
+	for i := 0; i < 32; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m.LoadOrCompute("shared", func() int { calls.Add(1); return 1 })
+		}()
+	}
+	wg.Wait()
+
+	if got := calls.Load(); got != 1 {
+		t.Fatalf("expected fn to run once, ran %d times", got)
+	}
+}
@@ -0,0 +1,416 @@
+package shardmap
+
+import (
+	"hash/maphash"
+	"sync"
+
+	"github.com/kwstars/gx/cmap"
+)
+
+// DefaultShardCount is the number of shards used by New and FromMap.
+const DefaultShardCount = 32
+
+// shard is a single RWMutex-guarded partition of the key space.
+type shard[K comparable, V any] struct {
+	mu    sync.RWMutex
+	store map[K]V // Guaranteed non-nil by newMap()
+}
+
+// shardMap implements cmap.Map by partitioning keys across independent shards.
+// Each key is assigned to a shard by hashing it, so writers to different shards
+// never contend on the same lock. This suits write-heavy workloads where a single
+// RWMutex or sync.Map degrades.
+// All methods assume m != nil, consistent with rwmap.
+type shardMap[K comparable, V any] struct {
+	shards []shard[K, V]
+	hash   func(K) uint64
+}
+
+// Ensure shardMap obeys cmap.Map interface at compile time.
+var _ cmap.Map[int, int] = (*shardMap[int, int])(nil)
+
+// New returns a cmap.Map backed by DefaultShardCount RWMutex-guarded shards.
+func New[K comparable, V any]() cmap.Map[K, V] {
+	return newMap[K, V](DefaultShardCount)
+}
+
+// NewWithShards returns a cmap.Map backed by n RWMutex-guarded shards.
+// A non-positive n selects DefaultShardCount.
+func NewWithShards[K comparable, V any](n int) cmap.Map[K, V] {
+	return newMap[K, V](n)
+}
+
+// FromMap returns a sharded cmap.Map populated with the entries of src.
+// Entries are copied, so src may be modified afterwards without affecting the result.
+func FromMap[K comparable, V any](src map[K]V) cmap.Map[K, V] {
+	m := newMap[K, V](DefaultShardCount)
+	for k, v := range src {
+		m.shardFor(k).store[k] = v
+	}
+	return m
+}
+
+// newMap exposes concrete type for callers needing assertions in tests.
+func newMap[K comparable, V any](n int) *shardMap[K, V] {
+	if n <= 0 {
+		n = DefaultShardCount
+	}
+	m := &shardMap[K, V]{
+		shards: make([]shard[K, V], n),
+		hash:   hasherFor[K](maphash.MakeSeed()),
+	}
+	for i := range m.shards {
+		m.shards[i].store = make(map[K]V) // Always initialized; never nil
+	}
+	return m
+}
+
+// hasherFor selects a hash function for K.
+// Strings are hashed with maphash.String and the built-in integer types with a
+// seeded 64-bit mixer, avoiding the generic path for the most common key types.
+// Any other comparable type (including named types whose underlying type is a
+// string or integer) falls back to maphash.Comparable, which hashes the key's
+// value the same way the built-in map does. Like the built-in map, the fallback
+// panics if an interface key holds a value that is not comparable.
+func hasherFor[K comparable](seed maphash.Seed) func(K) uint64 {
+	salt := maphash.String(seed, "")
+
+	var zero K
+	switch any(zero).(type) {
+	case string:
+		return func(k K) uint64 { return maphash.String(seed, any(k).(string)) }
+	case int:
+		return func(k K) uint64 { return mix64(uint64(any(k).(int)) ^ salt) }
+	case int8:
+		return func(k K) uint64 { return mix64(uint64(any(k).(int8)) ^ salt) }
+	case int16:
+		return func(k K) uint64 { return mix64(uint64(any(k).(int16)) ^ salt) }
+	case int32:
+		return func(k K) uint64 { return mix64(uint64(any(k).(int32)) ^ salt) }
+	case int64:
+		return func(k K) uint64 { return mix64(uint64(any(k).(int64)) ^ salt) }
+	case uint:
+		return func(k K) uint64 { return mix64(uint64(any(k).(uint)) ^ salt) }
+	case uint8:
+		return func(k K) uint64 { return mix64(uint64(any(k).(uint8)) ^ salt) }
+	case uint16:
+		return func(k K) uint64 { return mix64(uint64(any(k).(uint16)) ^ salt) }
+	case uint32:
+		return func(k K) uint64 { return mix64(uint64(any(k).(uint32)) ^ salt) }
+	case uint64:
+		return func(k K) uint64 { return mix64(any(k).(uint64) ^ salt) }
+	case uintptr:
+		return func(k K) uint64 { return mix64(uint64(any(k).(uintptr)) ^ salt) }
+	}
+	return func(k K) uint64 { return maphash.Comparable(seed, k) }
+}
+
+// mix64 is the SplitMix64 finalizer, spreading sequential integers across all bits.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// shardFor returns the shard owning key.
+func (m *shardMap[K, V]) shardFor(key K) *shard[K, V] {
+	return &m.shards[m.hash(key)%uint64(len(m.shards))]
+}
+
+// Load retrieves the value for key, returning ok=false when missing.
+func (m *shardMap[K, V]) Load(key K) (value V, ok bool) {
+	s := m.shardFor(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	value, ok = s.store[key]
+	return value, ok
+}
+
+// Store sets the value for key, overwriting any existing value.
+func (m *shardMap[K, V]) Store(key K, value V) {
+	s := m.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.store[key] = value
+}
+
+// LoadOrStore returns the existing value if present, storing otherwise.
+// Uses the shard's write lock to ensure atomic "check-then-act" semantics.
+func (m *shardMap[K, V]) LoadOrStore(key K, value V) (actual V, loaded bool) {
+	s := m.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if existing, ok := s.store[key]; ok {
+		return existing, true
+	}
+	s.store[key] = value
+	return value, false
+}
+
+// StoreIfAbsent stores value only if key is absent, reporting whether it was inserted.
+func (m *shardMap[K, V]) StoreIfAbsent(key K, value V) (inserted bool) {
+	_, loaded := m.LoadOrStore(key, value)
+	return !loaded
+}
+
+// StoreIfPresent replaces the value only if key is present, under the shard's write lock.
+// Returns the previous value and true if replaced, or the zero value and false if absent.
+func (m *shardMap[K, V]) StoreIfPresent(key K, value V) (previous V, replaced bool) {
+	s := m.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	previous, replaced = s.store[key]
+	if replaced {
+		s.store[key] = value
+	}
+	return previous, replaced
+}
+
+// LoadOrCompute returns the existing value if present; otherwise it runs fn and stores
+// its result. The hit path only takes the shard's read lock; on a miss fn runs under the
+// shard's write lock, so it runs at most once per absent key and must not call back
+// into the map.
+func (m *shardMap[K, V]) LoadOrCompute(key K, fn func() V) (actual V, loaded bool) {
+	actual, loaded, _ = m.LoadOrTryCompute(key, func() (V, error) { return fn(), nil })
+	return actual, loaded
+}
+
+// LoadOrTryCompute returns the existing value if present; otherwise it runs compute
+// and stores its result. A compute error is returned and nothing is stored.
+// On a miss compute runs under the shard's write lock, so it is invoked at most once
+// per absent key and must not call back into the map.
+func (m *shardMap[K, V]) LoadOrTryCompute(key K, compute func() (V, error)) (actual V, loaded bool, err error) {
+	s := m.shardFor(key)
+	s.mu.RLock()
+	existing, ok := s.store[key]
+	s.mu.RUnlock()
+	if ok {
+		return existing, true, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Double-check: another writer may have stored the key in between.
+	if existing, ok := s.store[key]; ok {
+		return existing, true, nil
+	}
+	value, err := compute()
+	if err != nil {
+		var zero V
+		return zero, false, err
+	}
+	s.store[key] = value
+	return value, false, nil
+}
+
+// Compute atomically updates the value for key using fn under the shard's write lock.
+// fn runs exactly once while the lock is held, so it must not call back into the map.
+func (m *shardMap[K, V]) Compute(key K, fn func(old V, loaded bool) (newValue V, delete bool)) (actual V, ok bool) {
+	s := m.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	old, loaded := s.store[key]
+	newValue, del := fn(old, loaded)
+	if del {
+		delete(s.store, key)
+		var zero V
+		return zero, false
+	}
+	s.store[key] = newValue
+	return newValue, true
+}
+
+// CompareAndSwap replaces the value for key with new if the current value equals old.
+// Runs under the shard's write lock; values are compared with == and panic if not comparable.
+func (m *shardMap[K, V]) CompareAndSwap(key K, old, new V) (swapped bool) {
+	s := m.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current, ok := s.store[key]
+	if !ok || any(current) != any(old) {
+		return false
+	}
+	s.store[key] = new
+	return true
+}
+
+// CompareAndDelete removes key if its current value equals old.
+// Runs under the shard's write lock; values are compared with == and panic if not comparable.
+func (m *shardMap[K, V]) CompareAndDelete(key K, old V) (deleted bool) {
+	s := m.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current, ok := s.store[key]
+	if !ok || any(current) != any(old) {
+		return false
+	}
+	delete(s.store, key)
+	return true
+}
+
+// LoadAndDelete removes key and returns prior value if it existed.
+func (m *shardMap[K, V]) LoadAndDelete(key K) (value V, loaded bool) {
+	s := m.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	value, loaded = s.store[key]
+	if loaded {
+		delete(s.store, key)
+	}
+	return value, loaded
+}
+
+// Delete removes the key without reporting previous value.
+func (m *shardMap[K, V]) Delete(key K) {
+	s := m.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.store, key)
+}
+
+// StoreAll stores every entry while holding all shard write locks, acquired once each
+// in ascending order. This makes the batch atomic to readers and avoids per-entry lock
+// traffic, at the cost of blocking every shard for the duration of the batch.
+func (m *shardMap[K, V]) StoreAll(entries map[K]V) {
+	if len(entries) == 0 {
+		return
+	}
+	m.lockAll()
+	defer m.unlockAll()
+	for k, v := range entries {
+		m.shardFor(k).store[k] = v
+	}
+}
+
+// DeleteAll removes every key while holding all shard write locks, like StoreAll.
+func (m *shardMap[K, V]) DeleteAll(keys []K) {
+	if len(keys) == 0 {
+		return
+	}
+	m.lockAll()
+	defer m.unlockAll()
+	for _, k := range keys {
+		delete(m.shardFor(k).store, k)
+	}
+}
+
+// lockAll acquires every shard's write lock in ascending order, so concurrent
+// callers cannot deadlock.
+func (m *shardMap[K, V]) lockAll() {
+	for i := range m.shards {
+		m.shards[i].mu.Lock()
+	}
+}
+
+// unlockAll releases the locks taken by lockAll.
+func (m *shardMap[K, V]) unlockAll() {
+	for i := range m.shards {
+		m.shards[i].mu.Unlock()
+	}
+}
+
+// Range iterates over snapshots of the shards until fn returns false.
+// Snapshot semantics:
+//   - Each shard is copied under its own read lock, one shard at a time
+//   - The result is not a point-in-time view across shards: a shard visited later
+//     may reflect writes made after an earlier shard was copied
+//   - No lock is held while fn executes, so fn may call back into the map
+func (m *shardMap[K, V]) Range(fn func(key K, value V) bool) {
+	if fn == nil {
+		return
+	}
+
+	for i := range m.shards {
+		s := &m.shards[i]
+		s.mu.RLock()
+		snapshot := make([]struct {
+			key K
+			val V
+		}, 0, len(s.store))
+		for k, v := range s.store {
+			snapshot = append(snapshot, struct {
+				key K
+				val V
+			}{k, v})
+		}
+		s.mu.RUnlock()
+
+		for _, item := range snapshot {
+			if !fn(item.key, item.val) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns the keys of the map in unspecified order.
+// Each shard is read under its own lock, so the result is not a point-in-time
+// snapshot across shards.
+func (m *shardMap[K, V]) Keys() []K {
+	keys := []K{}
+	for i := range m.shards {
+		s := &m.shards[i]
+		s.mu.RLock()
+		for k := range s.store {
+			keys = append(keys, k)
+		}
+		s.mu.RUnlock()
+	}
+	return keys
+}
+
+// Values returns the values of the map in unspecified order.
+// Each shard is read under its own lock, so the result is not a point-in-time
+// snapshot across shards.
+func (m *shardMap[K, V]) Values() []V {
+	values := []V{}
+	for i := range m.shards {
+		s := &m.shards[i]
+		s.mu.RLock()
+		for _, v := range s.store {
+			values = append(values, v)
+		}
+		s.mu.RUnlock()
+	}
+	return values
+}
+
+// ToMap returns a copy of all entries, disconnected from the map.
+// Each shard is copied under its own read lock, so the result is not a point-in-time
+// snapshot across shards.
+func (m *shardMap[K, V]) ToMap() map[K]V {
+	result := make(map[K]V)
+	for i := range m.shards {
+		s := &m.shards[i]
+		s.mu.RLock()
+		for k, v := range s.store {
+			result[k] = v
+		}
+		s.mu.RUnlock()
+	}
+	return result
+}
+
+// Len reports the number of key/value pairs in the map.
+// Shards are counted one at a time, so concurrent writes to other shards may
+// make the total slightly stale.
+func (m *shardMap[K, V]) Len() int {
+	total := 0
+	for i := range m.shards {
+		s := &m.shards[i]
+		s.mu.RLock()
+		total += len(s.store)
+		s.mu.RUnlock()
+	}
+	return total
+}
@@ -0,0 +1,382 @@
+package shardmap
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+)
+
+func TestShardMapStoreLoad(t *testing.T) {
+	t.Parallel()
+
+	m := New[string, int]()
+
+	if _, ok := m.Load("missing"); ok {
+		t.Fatalf("expected missing key to return ok=false")
+	}
+
+	m.Store("foo", 1)
+	if got, ok := m.Load("foo"); !ok || got != 1 {
+		t.Fatalf("expected foo=1, got %v ok=%v", got, ok)
+	}
+
+	m.Store("foo", 2)
+	if got, _ := m.Load("foo"); got != 2 {
+		t.Fatalf("expected foo=2 after overwrite, got %d", got)
+	}
+
+	if gotLen := m.Len(); gotLen != 1 {
+		t.Fatalf("expected len to remain 1 after overwrite, got %d", gotLen)
+	}
+}
+
+func TestShardMapLoadOrStoreAndDelete(t *testing.T) {
+	t.Parallel()
+
+	m := New[int, string]()
+
+	if actual, loaded := m.LoadOrStore(1, "a"); loaded || actual != "a" {
+		t.Fatalf("expected store to insert new value, got %q loaded=%v", actual, loaded)
+	}
+
+	if actual, loaded := m.LoadOrStore(1, "b"); !loaded || actual != "a" {
+		t.Fatalf("expected load of existing value, got %q loaded=%v", actual, loaded)
+	}
+
+	if val, loaded := m.LoadAndDelete(1); !loaded || val != "a" {
+		t.Fatalf("expected delete to return stored value, got %q loaded=%v", val, loaded)
+	}
+
+	if _, loaded := m.LoadAndDelete(1); loaded {
+		t.Fatalf("expected second delete to report loaded=false")
+	}
+
+	m.Store(2, "b")
+	m.Delete(2)
+	if gotLen := m.Len(); gotLen != 0 {
+		t.Fatalf("expected len=0 after delete, got %d", gotLen)
+	}
+}
+
+func TestShardMapDistribution(t *testing.T) {
+	t.Parallel()
+
+	m := newMap[int, int](8)
+	const total = 8000
+	for i := 0; i < total; i++ {
+		m.Store(i, i)
+	}
+
+	// Sequential keys must spread across every shard rather than piling into a few.
+	for i := range m.shards {
+		if n := len(m.shards[i].store); n < total/8/2 || n > total/8*2 {
+			t.Fatalf("expected roughly %d keys in shard %d, got %d", total/8, i, n)
+		}
+	}
+
+	if gotLen := m.Len(); gotLen != total {
+		t.Fatalf("expected len=%d, got %d", total, gotLen)
+	}
+}
+
+func TestShardMapKeyTypes(t *testing.T) {
+	t.Parallel()
+
+	type userID string
+	type point struct{ x, y int }
+
+	named := New[userID, int]()
+	named.Store("alice", 1)
+	if got, ok := named.Load("alice"); !ok || got != 1 {
+		t.Fatalf("expected alice=1 via fallback hasher, got %v ok=%v", got, ok)
+	}
+
+	structs := NewWithShards[point, string](3)
+	structs.Store(point{1, 2}, "a")
+	if got, ok := structs.Load(point{1, 2}); !ok || got != "a" {
+		t.Fatalf("expected struct key lookup to succeed, got %q ok=%v", got, ok)
+	}
+
+	small := New[uint8, int]()
+	for i := 0; i < 256; i++ {
+		small.Store(uint8(i), i)
+	}
+	if gotLen := small.Len(); gotLen != 256 {
+		t.Fatalf("expected len=256, got %d", gotLen)
+	}
+
+	if n := len(newMap[int, int](0).shards); n != DefaultShardCount {
+		t.Fatalf("expected %d shards for non-positive n, got %d", DefaultShardCount, n)
+	}
+}
+
+func TestShardMapRangeAndConcurrency(t *testing.T) {
+	t.Parallel()
+
+	m := New[int, int]()
+	const total = 256
+
+	var wg sync.WaitGroup
+	for i := 0; i < total; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			m.Store(i, i*i)
+			m.Load(i)
+		}(i)
+	}
+	wg.Wait()
+
+	if gotLen := m.Len(); gotLen != total {
+		t.Fatalf("expected len=%d after concurrent writes, got %d", total, gotLen)
+	}
+
+	seen := make(map[int]int)
+	m.Range(func(k, v int) bool {
+		seen[k] = v
+		return len(seen) < 10
+	})
+	if len(seen) != 10 {
+		t.Fatalf("expected range to stop after 10 iterations, got %d", len(seen))
+	}
+
+	// No lock is held during the callback, so writing back into the map must not deadlock.
+	m.Range(func(k, v int) bool {
+		m.Store(k, v+1)
+		return true
+	})
+	if got, _ := m.Load(3); got != 10 {
+		t.Fatalf("expected 3=10 after range update, got %d", got)
+	}
+}
+
+func TestShardMapFromMap(t *testing.T) {
+	t.Parallel()
+
+	src := map[string]int{"a": 1, "b": 2}
+	m := FromMap(src)
+
+	src["a"] = 100
+	src["c"] = 3
+
+	if gotLen := m.Len(); gotLen != 2 {
+		t.Fatalf("expected len=2, got %d", gotLen)
+	}
+	if got, ok := m.Load("a"); !ok || got != 1 {
+		t.Fatalf("expected a=1 unaffected by source mutation, got %v ok=%v", got, ok)
+	}
+}
+
+func TestShardMapLoadOrTryCompute(t *testing.T) {
+	t.Parallel()
+
+	m := New[string, int]()
+	errBackend := errors.New("backend unavailable")
+
+	if _, loaded, err := m.LoadOrTryCompute("a", func() (int, error) { return 0, errBackend }); err != errBackend || loaded {
+		t.Fatalf("expected compute error and loaded=false, got err=%v loaded=%v", err, loaded)
+	}
+	if _, ok := m.Load("a"); ok {
+		t.Fatalf("expected nothing stored after compute error")
+	}
+
+	if actual, loaded, err := m.LoadOrTryCompute("a", func() (int, error) { return 1, nil }); err != nil || loaded || actual != 1 {
+		t.Fatalf("expected computed value 1, got %d loaded=%v err=%v", actual, loaded, err)
+	}
+
+	calls := 0
+	actual, loaded, err := m.LoadOrTryCompute("a", func() (int, error) { calls++; return 2, nil })
+	if err != nil || !loaded || actual != 1 || calls != 0 {
+		t.Fatalf("expected existing value without compute, got %d loaded=%v err=%v calls=%d", actual, loaded, err, calls)
+	}
+}
+
+func TestShardMapCompute(t *testing.T) {
+	t.Parallel()
+
+	m := New[string, int]()
+	increment := func(old int, _ bool) (int, bool) { return old + 1, false }
+
+	const workers, perWorker = 16, 200
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < perWorker; j++ {
+				m.Compute("hits", increment)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got, _ := m.Load("hits"); got != workers*perWorker {
+		t.Fatalf("expected %d after concurrent increments, got %d", workers*perWorker, got)
+	}
+
+	if actual, ok := m.Compute("hits", func(int, bool) (int, bool) { return 0, true }); ok || actual != 0 {
+		t.Fatalf("expected delete, got %d ok=%v", actual, ok)
+	}
+	if _, ok := m.Load("hits"); ok {
+		t.Fatalf("expected key to be removed")
+	}
+}
+
+func TestShardMapKeysValues(t *testing.T) {
+	t.Parallel()
+
+	m := New[string, int]()
+	if keys, values := m.Keys(), m.Values(); keys == nil || len(keys) != 0 || values == nil || len(values) != 0 {
+		t.Fatalf("expected empty non-nil snapshots, got keys=%v values=%v", keys, values)
+	}
+
+	m.Store("a", 1)
+	m.Store("b", 2)
+	m.Store("c", 3)
+
+	keys := m.Keys()
+	sort.Strings(keys)
+	if len(keys) != 3 || keys[0] != "a" || keys[2] != "c" {
+		t.Fatalf("expected keys [a b c], got %v", keys)
+	}
+
+	values := m.Values()
+	sort.Ints(values)
+	if len(values) != 3 || values[0] != 1 || values[2] != 3 {
+		t.Fatalf("expected values [1 2 3], got %v", values)
+	}
+}
+
+func TestShardMapStoreIfAbsentIfPresent(t *testing.T) {
+	t.Parallel()
+
+	m := New[string, int]()
+
+	if prev, replaced := m.StoreIfPresent("a", 1); replaced || prev != 0 {
+		t.Fatalf("expected no update for absent key, got %d replaced=%v", prev, replaced)
+	}
+	if _, ok := m.Load("a"); ok {
+		t.Fatalf("expected StoreIfPresent not to insert")
+	}
+
+	if !m.StoreIfAbsent("a", 1) {
+		t.Fatalf("expected insert of absent key")
+	}
+	if m.StoreIfAbsent("a", 2) {
+		t.Fatalf("expected no insert for present key")
+	}
+	if got, _ := m.Load("a"); got != 1 {
+		t.Fatalf("expected a=1 to be kept, got %d", got)
+	}
+
+	if prev, replaced := m.StoreIfPresent("a", 3); !replaced || prev != 1 {
+		t.Fatalf("expected replace of 1, got %d replaced=%v", prev, replaced)
+	}
+	if got, _ := m.Load("a"); got != 3 {
+		t.Fatalf("expected a=3 after refresh, got %d", got)
+	}
+}
+
+func TestShardMapCompareAndSwapDelete(t *testing.T) {
+	t.Parallel()
+
+	m := New[string, int]()
+	if m.CompareAndSwap("a", 0, 1) {
+		t.Fatalf("expected swap of absent key to fail")
+	}
+
+	m.Store("a", 1)
+	if m.CompareAndSwap("a", 2, 3) {
+		t.Fatalf("expected swap with stale old value to fail")
+	}
+	if !m.CompareAndSwap("a", 1, 2) {
+		t.Fatalf("expected swap with current value to succeed")
+	}
+
+	// Optimistic-concurrency counter built from Load and CompareAndSwap.
+	const workers, perWorker = 8, 100
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < perWorker; j++ {
+				for {
+					current, _ := m.Load("a")
+					if m.CompareAndSwap("a", current, current+1) {
+						break
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got, _ := m.Load("a"); got != 2+workers*perWorker {
+		t.Fatalf("expected %d after optimistic increments, got %d", 2+workers*perWorker, got)
+	}
+
+	if m.CompareAndDelete("a", 0) {
+		t.Fatalf("expected delete with stale old value to fail")
+	}
+	if !m.CompareAndDelete("a", 2+workers*perWorker) {
+		t.Fatalf("expected delete with current value to succeed")
+	}
+	if _, ok := m.Load("a"); ok {
+		t.Fatalf("expected key to be removed")
+	}
+}
+
+func TestShardMapCompareAndSwapNonComparable(t *testing.T) {
+	t.Parallel()
+
+	m := New[string, []int]()
+	m.Store("a", []int{1})
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("expected panic for non-comparable values")
+		}
+	}()
+	m.CompareAndSwap("a", []int{1}, []int{2})
+}
+
+func TestShardMapStoreAllDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	m := New[int, string]()
+	m.Store(1, "old")
+
+	m.StoreAll(map[int]string{1: "a", 2: "b", 3: "c"})
+	if gotLen := m.Len(); gotLen != 3 {
+		t.Fatalf("expected len=3 after StoreAll, got %d", gotLen)
+	}
+	if got, _ := m.Load(1); got != "a" {
+		t.Fatalf("expected StoreAll to overwrite 1, got %q", got)
+	}
+
+	m.StoreAll(nil)
+	m.DeleteAll([]int{1, 3, 99})
+	if gotLen := m.Len(); gotLen != 1 {
+		t.Fatalf("expected len=1 after DeleteAll, got %d", gotLen)
+	}
+	if got, ok := m.Load(2); !ok || got != "b" {
+		t.Fatalf("expected 2=b to remain, got %q ok=%v", got, ok)
+	}
+}
+
+func TestShardMapLoadOrCompute(t *testing.T) {
+	t.Parallel()
+
+	m := New[string, int]()
+	calls := 0
+	build := func() int { calls++; return 42 }
+
+	if actual, loaded := m.LoadOrCompute("conn", build); loaded || actual != 42 || calls != 1 {
+		t.Fatalf("expected computed 42 on miss, got %d loaded=%v calls=%d", actual, loaded, calls)
+	}
+	if actual, loaded := m.LoadOrCompute("conn", build); !loaded || actual != 42 || calls != 1 {
+		t.Fatalf("expected cached 42 without compute, got %d loaded=%v calls=%d", actual, loaded, calls)
+	}
+}
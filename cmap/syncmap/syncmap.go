@@ -9,8 +9,18 @@ import (
 // syncMap implements cmap.Map by wrapping sync.Map with typed helpers.
 // Note: Len() is approximate and computed by iteration since sync.Map
 // does not provide atomic length tracking.
+//
+// Every value is stored behind a freshly allocated *V, so the CompareAndSwap and
+// CompareAndDelete calls used by the read-modify-write helpers compare pointer identity
+// rather than values. Those helpers therefore terminate for values that are not equal to
+// themselves (such as NaN) and work with non-comparable types (such as slices).
 type syncMap[K comparable, V any] struct {
-	store sync.Map
+	store sync.Map // K -> *V
+}
+
+// box returns a new pointer holding v, giving each stored value a distinct identity.
+func box[V any](v V) *V {
+	return &v
 }
 
 // Ensure syncMap satisfies the cmap.Map interface at compile time.
@@ -22,6 +32,16 @@ func New[K comparable, V any]() cmap.Map[K, V] {
 	return &syncMap[K, V]{}
 }
 
+// FromMap returns a cmap.Map backed by sync.Map and populated with the entries of src.
+// Entries are copied, so src may be modified afterwards without affecting the result.
+func FromMap[K comparable, V any](src map[K]V) cmap.Map[K, V] {
+	m := &syncMap[K, V]{}
+	for k, v := range src {
+		m.store.Store(k, box(v))
+	}
+	return m
+}
+
 // Load retrieves the value for key.
 // Returns (zeroValue, false) if key does not exist or m is nil.
 func (m *syncMap[K, V]) Load(key K) (value V, ok bool) {
@@ -34,7 +54,7 @@ func (m *syncMap[K, V]) Load(key K) (value V, ok bool) {
 		var zero V
 		return zero, false
 	}
-	return raw.(V), true
+	return *raw.(*V), true
 }
 
 // Store sets the value for key, replacing any existing entry.
@@ -43,7 +63,7 @@ func (m *syncMap[K, V]) Store(key K, value V) {
 	if m == nil {
 		return
 	}
-	m.store.Store(key, value)
+	m.store.Store(key, box(value))
 }
 
 // LoadOrStore returns the existing value if present; otherwise stores and returns value.
@@ -52,8 +72,142 @@ func (m *syncMap[K, V]) LoadOrStore(key K, value V) (actual V, loaded bool) {
 	if m == nil {
 		return value, false
 	}
-	raw, ok := m.store.LoadOrStore(key, value)
-	return raw.(V), ok
+	raw, ok := m.store.LoadOrStore(key, box(value))
+	return *raw.(*V), ok
+}
+
+// StoreIfAbsent stores value only if key is absent, reporting whether it was inserted.
+// Safe for concurrent use. Only one goroutine will insert a value for a given key.
+func (m *syncMap[K, V]) StoreIfAbsent(key K, value V) (inserted bool) {
+	if m == nil {
+		return false
+	}
+	_, loaded := m.store.LoadOrStore(key, box(value))
+	return !loaded
+}
+
+// StoreIfPresent replaces the value only if key is present.
+// A plain Swap would insert the key if it were deleted concurrently, so this uses a
+// CompareAndSwap retry loop on the stored pointer instead. No values are compared, so it
+// works for any V and retries only after a concurrent write.
+func (m *syncMap[K, V]) StoreIfPresent(key K, value V) (previous V, replaced bool) {
+	var zero V
+	if m == nil {
+		return zero, false
+	}
+	for {
+		raw, ok := m.store.Load(key)
+		if !ok {
+			return zero, false
+		}
+		if m.store.CompareAndSwap(key, raw, box(value)) {
+			return *raw.(*V), true
+		}
+	}
+}
+
+// LoadOrCompute returns the existing value if present; otherwise it runs fn and stores
+// its result. There is no single-flight guarantee: fn runs without any lock, so
+// concurrent callers missing on the same key may each invoke it, and all of them
+// receive the first value stored. Keep fn idempotent and free of side effects that
+// must happen only once.
+func (m *syncMap[K, V]) LoadOrCompute(key K, fn func() V) (actual V, loaded bool) {
+	actual, loaded, _ = m.LoadOrTryCompute(key, func() (V, error) { return fn(), nil })
+	return actual, loaded
+}
+
+// LoadOrTryCompute returns the existing value if present; otherwise it runs compute
+// and stores its result. A compute error is returned and nothing is stored.
+// compute runs without any lock, so concurrent callers racing on the same absent key
+// may each invoke it; only the first stored result wins and is returned to all of them.
+func (m *syncMap[K, V]) LoadOrTryCompute(key K, compute func() (V, error)) (actual V, loaded bool, err error) {
+	if m == nil {
+		var zero V
+		return zero, false, nil
+	}
+	if raw, ok := m.store.Load(key); ok {
+		return *raw.(*V), true, nil
+	}
+	value, err := compute()
+	if err != nil {
+		var zero V
+		return zero, false, err
+	}
+	raw, ok := m.store.LoadOrStore(key, box(value))
+	return *raw.(*V), ok, nil
+}
+
+// Compute atomically updates the value for key using fn.
+// It runs a retry loop around LoadOrStore, CompareAndSwap and CompareAndDelete, so fn may be
+// invoked more than once under contention and must be free of side effects. The swaps compare
+// the stored pointers rather than values, so a retry happens only after a concurrent write and
+// any V, including NaN floats and non-comparable types, is supported.
+func (m *syncMap[K, V]) Compute(key K, fn func(old V, loaded bool) (newValue V, delete bool)) (actual V, ok bool) {
+	var zero V
+	if m == nil {
+		return zero, false
+	}
+	for {
+		raw, loaded := m.store.Load(key)
+		old := zero
+		if loaded {
+			old = *raw.(*V)
+		}
+
+		newValue, del := fn(old, loaded)
+		switch {
+		case del && !loaded:
+			return zero, false
+		case del:
+			if m.store.CompareAndDelete(key, raw) {
+				return zero, false
+			}
+		case !loaded:
+			if _, exists := m.store.LoadOrStore(key, box(newValue)); !exists {
+				return newValue, true
+			}
+		default:
+			if m.store.CompareAndSwap(key, raw, box(newValue)) {
+				return newValue, true
+			}
+		}
+		// Another goroutine changed the entry in between; retry with the fresh value.
+	}
+}
+
+// CompareAndSwap replaces the value for key with new if the current value equals old.
+// Values are compared with ==, so they must be comparable at runtime or it panics.
+// The swap itself is made on the stored pointer and retried only after a concurrent write.
+func (m *syncMap[K, V]) CompareAndSwap(key K, old, new V) (swapped bool) {
+	if m == nil {
+		return false
+	}
+	for {
+		raw, ok := m.store.Load(key)
+		if !ok || any(*raw.(*V)) != any(old) {
+			return false
+		}
+		if m.store.CompareAndSwap(key, raw, box(new)) {
+			return true
+		}
+	}
+}
+
+// CompareAndDelete removes key if its current value equals old.
+// Values are compared with ==, so they must be comparable at runtime or it panics.
+func (m *syncMap[K, V]) CompareAndDelete(key K, old V) (deleted bool) {
+	if m == nil {
+		return false
+	}
+	for {
+		raw, ok := m.store.Load(key)
+		if !ok || any(*raw.(*V)) != any(old) {
+			return false
+		}
+		if m.store.CompareAndDelete(key, raw) {
+			return true
+		}
+	}
 }
 
 // LoadAndDelete removes the key and returns its previous value.
@@ -68,7 +222,7 @@ func (m *syncMap[K, V]) LoadAndDelete(key K) (value V, loaded bool) {
 		var zero V
 		return zero, false
 	}
-	return raw.(V), true
+	return *raw.(*V), true
 }
 
 // Delete removes the key without returning the previous value.
@@ -80,6 +234,29 @@ func (m *syncMap[K, V]) Delete(key K) {
 	m.store.Delete(key)
 }
 
+// StoreAll stores every entry, replacing existing values.
+// sync.Map has no batch operation, so this is a loop of Store calls and other
+// goroutines may observe the batch partially applied.
+func (m *syncMap[K, V]) StoreAll(entries map[K]V) {
+	if m == nil {
+		return
+	}
+	for k, v := range entries {
+		m.store.Store(k, box(v))
+	}
+}
+
+// DeleteAll removes every key in keys; absent keys are ignored.
+// Like StoreAll, this is a loop and is not atomic as a whole.
+func (m *syncMap[K, V]) DeleteAll(keys []K) {
+	if m == nil {
+		return
+	}
+	for _, k := range keys {
+		m.store.Delete(k)
+	}
+}
+
 // Range iterates over the map until the provided function returns false.
 // The iteration is safe for concurrent use, but the map may be modified
 // during iteration. The function fn must not modify the map.
@@ -88,8 +265,44 @@ func (m *syncMap[K, V]) Range(fn func(key K, value V) bool) {
 		return
 	}
 	m.store.Range(func(k, v any) bool {
-		return fn(k.(K), v.(V))
+		return fn(k.(K), *v.(*V))
+	})
+}
+
+// Keys returns the keys of the map in unspecified order.
+// Like Range, the result is not a point-in-time snapshot: keys stored or deleted
+// concurrently may or may not be included.
+func (m *syncMap[K, V]) Keys() []K {
+	keys := []K{}
+	m.Range(func(k K, _ V) bool {
+		keys = append(keys, k)
+		return true
+	})
+	return keys
+}
+
+// Values returns the values of the map in unspecified order.
+// Like Range, the result is not a point-in-time snapshot: entries modified
+// concurrently may or may not be reflected.
+func (m *syncMap[K, V]) Values() []V {
+	values := []V{}
+	m.Range(func(_ K, v V) bool {
+		values = append(values, v)
+		return true
+	})
+	return values
+}
+
+// ToMap returns a copy of all entries built by ranging over the map.
+// The result is disconnected from the map, but like Range it is not a point-in-time
+// snapshot: entries modified concurrently may or may not be reflected.
+func (m *syncMap[K, V]) ToMap() map[K]V {
+	result := make(map[K]V)
+	m.Range(func(k K, v V) bool {
+		result[k] = v
+		return true
 	})
+	return result
 }
 
 // Len reports an approximate number of key/value pairs in the map.
@@ -15,12 +15,29 @@ func GetDateStart(t time.Time) time.Time {
 	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
 }
 
+// startOfWeek returns the start of the ISO week (Monday 00:00:00) containing the given time.
+func startOfWeek(t time.Time) time.Time {
+	// time.Weekday counts from Sunday; shift so Monday is day 0.
+	offset := (int(t.Weekday()) + 6) % 7
+	return GetDateStart(t).AddDate(0, 0, -offset)
+}
+
+// startOfMonth returns the first day of the month (00:00:00) containing the given time.
+func startOfMonth(t time.Time) time.Time {
+	y, m, _ := t.Date()
+	return time.Date(y, m, 1, 0, 0, 0, 0, t.Location())
+}
+
+// ToUTCMidnight returns midnight UTC of the calendar date of t in t's own location.
+// Because UTC has no DST transitions, days normalized this way are always exactly 24 hours apart,
+// which makes the result safe for day-difference arithmetic.
+func ToUTCMidnight(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
 func CalculateDateDifference(t1, t2 time.Time) int {
-	y1, m1, d1 := t1.Date()
-	y2, m2, d2 := t2.Date()
-	date1 := time.Date(y1, m1, d1, 0, 0, 0, 0, time.UTC)
-	date2 := time.Date(y2, m2, d2, 0, 0, 0, 0, time.UTC)
-	diff := date2.Sub(date1)
+	diff := ToUTCMidnight(t2).Sub(ToUTCMidnight(t1))
 	return int(diff.Hours() / 24)
 }
 
@@ -0,0 +1,37 @@
+package datex
+
+import (
+	"testing"
+	"time"
+)
+
+func TestToUTCMidnight(t *testing.T) {
+	t.Parallel()
+
+	loc := time.FixedZone("UTC+9", 9*60*60)
+	// 2024-03-10 01:00 in UTC+9 is still 2024-03-09 in UTC; the local calendar date wins.
+	in := time.Date(2024, 3, 10, 1, 0, 0, 0, loc)
+	want := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+	if got := ToUTCMidnight(in); !got.Equal(want) || got.Location() != time.UTC {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestCalculateDateDifferenceAcrossDST(t *testing.T) {
+	t.Parallel()
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+
+	// DST starts on 2024-03-10; the local day is only 23 hours long.
+	start := time.Date(2024, 3, 9, 12, 0, 0, 0, loc)
+	end := time.Date(2024, 3, 11, 0, 30, 0, 0, loc)
+	if got := CalculateDateDifference(start, end); got != 2 {
+		t.Errorf("expected 2 days, got %d", got)
+	}
+	if got := GetDateRangeDays(start, end); got != 2 {
+		t.Errorf("expected 2 days, got %d", got)
+	}
+}
@@ -1,6 +1,11 @@
 package datex
 
-import "time"
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
 
 // IsWithinDateRange checks if the target date is within the specified start and end dates.
 func IsWithinDateRange(startDate, endDate, targetDate time.Time) bool {
@@ -9,10 +14,54 @@ func IsWithinDateRange(startDate, endDate, targetDate time.Time) bool {
 
 // GetDateRangeDays returns the number of days between the start and end dates.
 func GetDateRangeDays(startDate, endDate time.Time) int {
-	y1, m1, d1 := startDate.Date()
-	y2, m2, d2 := endDate.Date()
-	date1 := time.Date(y1, m1, d1, 0, 0, 0, 0, time.UTC)
-	date2 := time.Date(y2, m2, d2, 0, 0, 0, 0, time.UTC)
-	diff := date2.Sub(date1)
+	diff := ToUTCMidnight(endDate).Sub(ToUTCMidnight(startDate))
 	return int(diff.Hours() / 24)
 }
+
+// ParseRelativeRange resolves a named relative period into the half-open range [start, end)
+// relative to now, in now's location.
+//
+// Supported expressions are "today", "yesterday", "last7days", "last30days", "thisweek",
+// "lastweek", "thismonth" and "lastmonth". Matching is case-insensitive and ignores spaces,
+// underscores and hyphens, so "Last 7 days" is equivalent to "last7days". Weeks start on Monday.
+// The "lastNdays" ranges include today. An unknown expression returns an error.
+func ParseRelativeRange(expr string, now time.Time) (start, end time.Time, err error) {
+	today := GetDateStart(now)
+	tomorrow := today.AddDate(0, 0, 1)
+
+	switch normalizeRangeExpr(expr) {
+	case "today":
+		return today, tomorrow, nil
+	case "yesterday":
+		return today.AddDate(0, 0, -1), today, nil
+	case "last7days":
+		return today.AddDate(0, 0, -6), tomorrow, nil
+	case "last30days":
+		return today.AddDate(0, 0, -29), tomorrow, nil
+	case "thisweek":
+		weekStart := startOfWeek(now)
+		return weekStart, weekStart.AddDate(0, 0, 7), nil
+	case "lastweek":
+		weekStart := startOfWeek(now)
+		return weekStart.AddDate(0, 0, -7), weekStart, nil
+	case "thismonth":
+		monthStart := startOfMonth(now)
+		return monthStart, monthStart.AddDate(0, 1, 0), nil
+	case "lastmonth":
+		monthStart := startOfMonth(now)
+		return monthStart.AddDate(0, -1, 0), monthStart, nil
+	default:
+		return time.Time{}, time.Time{}, fmt.Errorf("datex: unknown relative range %q", expr)
+	}
+}
+
+// normalizeRangeExpr lowercases expr and strips separators.
+func normalizeRangeExpr(expr string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '_', '-':
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, strings.TrimSpace(expr))
+}
@@ -0,0 +1,60 @@
+package datex
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRelativeRange(t *testing.T) {
+	t.Parallel()
+
+	// Wednesday
+	now := time.Date(2024, 3, 13, 15, 30, 0, 0, time.UTC)
+	day := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		expr      string
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{"today", day(2024, 3, 13), day(2024, 3, 14)},
+		{"yesterday", day(2024, 3, 12), day(2024, 3, 13)},
+		{"last7days", day(2024, 3, 7), day(2024, 3, 14)},
+		{"Last 7 days", day(2024, 3, 7), day(2024, 3, 14)},
+		{"last30days", day(2024, 2, 13), day(2024, 3, 14)},
+		{"thisweek", day(2024, 3, 11), day(2024, 3, 18)},
+		{"last_week", day(2024, 3, 4), day(2024, 3, 11)},
+		{"thismonth", day(2024, 3, 1), day(2024, 4, 1)},
+		{"lastmonth", day(2024, 2, 1), day(2024, 3, 1)},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.expr, func(t *testing.T) {
+			t.Parallel()
+			start, end, err := ParseRelativeRange(tt.expr, now)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !start.Equal(tt.wantStart) || !end.Equal(tt.wantEnd) {
+				t.Errorf("expected [%v, %v), got [%v, %v)", tt.wantStart, tt.wantEnd, start, end)
+			}
+		})
+	}
+
+	if _, _, err := ParseRelativeRange("next fortnight", now); err == nil {
+		t.Errorf("expected error for unknown expression")
+	}
+}
+
+func TestStartOfWeekSunday(t *testing.T) {
+	t.Parallel()
+
+	sunday := time.Date(2024, 3, 17, 10, 0, 0, 0, time.UTC)
+	want := time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC)
+	if got := startOfWeek(sunday); !got.Equal(want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,93 @@
+package randx
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// FloatPicker is a generic weighted random picker with float64 weights.
+//
+// It mirrors Picker but keeps fractional weights without scaling. Weights do
+// not need to sum to one; selection is proportional to each item's share of
+// totalWeight.
+type FloatPicker[T any] struct {
+	items       []T
+	prefixSums  []float64
+	totalWeight float64
+}
+
+// NewFloat constructs a FloatPicker for the provided items using weightFunc
+// to obtain a float64 weight for each item.
+//
+// Fractional weights are kept as-is, avoiding the precision loss of scaling
+// them to integers for Picker. An error is returned if any weight is negative,
+// NaN or infinite, or if the weights sum to zero (including when items is
+// empty).
+func NewFloat[T any](items []T, weightFunc func(T) float64) (*FloatPicker[T], error) {
+	return newFloatPicker(items, weightFunc)
+}
+
+// NewFromProbabilities constructs a FloatPicker that samples the keys of probs
+// in proportion to their values.
+//
+// The probabilities are normalized implicitly, so they need not sum exactly to
+// one. An error is returned if any probability is negative, NaN or infinite,
+// or if they sum to zero.
+func NewFromProbabilities[K comparable](probs map[K]float64) (*FloatPicker[K], error) {
+	keys := make([]K, 0, len(probs))
+	for k := range probs {
+		keys = append(keys, k)
+	}
+	return newFloatPicker(keys, func(k K) float64 { return probs[k] })
+}
+
+// newFloatPicker builds the prefix sums for items and validates their weights.
+func newFloatPicker[T any](items []T, weightFunc func(T) float64) (*FloatPicker[T], error) {
+	p := &FloatPicker[T]{
+		items:      items,
+		prefixSums: make([]float64, len(items)),
+	}
+
+	sum := 0.0
+	for i, item := range items {
+		weight := weightFunc(item)
+		if weight < 0 || math.IsNaN(weight) || math.IsInf(weight, 0) {
+			return nil, fmt.Errorf("randx: invalid weight %v at index %d", weight, i)
+		}
+		sum += weight
+		p.prefixSums[i] = sum
+	}
+	if sum <= 0 || math.IsInf(sum, 0) {
+		return nil, fmt.Errorf("randx: total weight must be positive and finite, got %v", sum)
+	}
+	p.totalWeight = sum
+
+	return p, nil
+}
+
+// Pick returns a randomly selected item according to the configured weights.
+//
+// Items with zero weight are never selected. If the FloatPicker contains no
+// items Pick returns ErrEmptyPicker.
+func (p *FloatPicker[T]) Pick() (T, error) {
+	if len(p.items) == 0 {
+		var zero T
+		return zero, &ErrEmptyPicker{}
+	}
+
+	f, err := RandFloat64()
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	x := f * p.totalWeight
+
+	// The first prefix sum strictly greater than x owns the draw.
+	index := sort.Search(len(p.prefixSums), func(i int) bool { return p.prefixSums[i] > x })
+	if index == len(p.prefixSums) {
+		// Guard against floating-point rounding at the upper edge.
+		index = sort.SearchFloat64s(p.prefixSums, p.totalWeight)
+	}
+	return p.items[index], nil
+}
@@ -0,0 +1,102 @@
+package randx
+
+import (
+	"math"
+	"testing"
+)
+
+// TestNewFromProbabilities tests sampling from a probability map.
+func TestNewFromProbabilities(t *testing.T) {
+	t.Parallel()
+	probs := map[string]float64{"a": 0.1, "b": 0.3, "c": 0.6, "never": 0}
+
+	picker, err := NewFromProbabilities(probs)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	counts := make(map[string]int)
+	const iterations = 200000
+
+	for i := 0; i < iterations; i++ {
+		picked, err := picker.Pick()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		counts[picked]++
+	}
+
+	if counts["never"] != 0 {
+		t.Errorf("Zero-probability key was picked %d times", counts["never"])
+	}
+
+	for key, p := range probs {
+		actual := float64(counts[key]) / float64(iterations)
+		if diff := abs(p - actual); diff > 0.01 {
+			t.Errorf("Key %q: expected frequency %.4f, got %.4f", key, p, actual)
+		}
+	}
+}
+
+// TestNewFromProbabilitiesInvalid tests construction-time validation.
+func TestNewFromProbabilitiesInvalid(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		probs map[string]float64
+	}{
+		{name: "negative", probs: map[string]float64{"a": 0.5, "b": -0.1}},
+		{name: "nan", probs: map[string]float64{"a": math.NaN()}},
+		{name: "infinite", probs: map[string]float64{"a": math.Inf(1)}},
+		{name: "all zero", probs: map[string]float64{"a": 0, "b": 0}},
+		{name: "empty", probs: map[string]float64{}},
+	}
+
+	for _, tt := range tests {
+		if _, err := NewFromProbabilities(tt.probs); err == nil {
+			t.Errorf("%s: expected error, got nil", tt.name)
+		}
+	}
+}
+
+// TestNewFloat tests fractional weights and construction-time validation.
+func TestNewFloat(t *testing.T) {
+	t.Parallel()
+	type item struct {
+		name  string
+		score float64
+	}
+
+	items := []item{{"low", 0.25}, {"zero", 0}, {"high", 0.75}}
+	picker, err := NewFloat(items, func(i item) float64 { return i.score })
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	counts := make(map[string]int)
+	const iterations = 100000
+	for i := 0; i < iterations; i++ {
+		picked, err := picker.Pick()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		counts[picked.name]++
+	}
+
+	if counts["zero"] != 0 {
+		t.Errorf("Zero-weight item was picked %d times", counts["zero"])
+	}
+	if actual := float64(counts["high"]) / iterations; abs(actual-0.75) > 0.01 {
+		t.Errorf("Expected frequency 0.75 for high, got %.4f", actual)
+	}
+
+	invalid := []float64{-0.5, math.NaN(), math.Inf(1)}
+	for _, w := range invalid {
+		if _, err := NewFloat([]float64{1, w}, func(f float64) float64 { return f }); err == nil {
+			t.Errorf("Expected error for weight %v", w)
+		}
+	}
+	if _, err := NewFloat([]float64{}, func(f float64) float64 { return f }); err == nil {
+		t.Errorf("Expected error for empty items")
+	}
+}
@@ -5,6 +5,8 @@ package randx
 
 import (
 	"crypto/rand"
+	"fmt"
+	"io"
 	"math/big"
 	"sort"
 )
@@ -13,11 +15,13 @@ import (
 //
 // Picker holds the original items, a slice of prefix sums for fast selection,
 // and the totalWeight of all items computed at construction time. Weights are
-// integer values provided by the caller via weightFunc.
+// integer values provided by the caller via weightFunc. The random source
+// defaults to crypto/rand and can be replaced with NewWithReader.
 type Picker[T any] struct {
 	items       []T
 	prefixSums  []int
 	totalWeight int
+	reader      io.Reader
 }
 
 // New constructs a Picker for the provided items using weightFunc to obtain
@@ -43,6 +47,49 @@ func New[T any](items []T, weightFunc func(T) int) *Picker[T] {
 	return p
 }
 
+// NewChecked is like New but validates the weights up front.
+//
+// It returns an error if any weight is negative or if the weights sum to zero
+// (including when items is empty), so a misconfigured distribution fails at
+// construction instead of on the first call to Pick. New is kept unchanged
+// for callers that rely on its lenient behavior.
+//
+// weightFunc is called exactly once per item, as with New.
+func NewChecked[T any](items []T, weightFunc func(T) int) (*Picker[T], error) {
+	p := &Picker[T]{
+		items:      items,
+		prefixSums: make([]int, len(items)),
+	}
+
+	sum := 0
+	for i, item := range items {
+		weight := weightFunc(item)
+		if weight < 0 {
+			return nil, fmt.Errorf("randx: negative weight %d at index %d", weight, i)
+		}
+		sum += weight
+		p.prefixSums[i] = sum
+	}
+	p.totalWeight = sum
+
+	if p.totalWeight <= 0 {
+		return nil, fmt.Errorf("randx: total weight must be positive, got %d", p.totalWeight)
+	}
+	return p, nil
+}
+
+// NewWithReader is like New but makes the Picker draw its randomness from r
+// instead of crypto/rand.
+//
+// This allows deterministic tests with a seeded source, or a faster PRNG when
+// selection does not need to be cryptographically secure. A nil r selects
+// crypto/rand. The Picker is only as safe for concurrent use as r is.
+func NewWithReader[T any](items []T, weightFunc func(T) int, r io.Reader) *Picker[T] {
+	p := New(items, weightFunc)
+	p.reader = r
+	return p
+}
+
 // Pick returns a randomly selected item according to the configured weights.
 //
 // The selection is proportional to each item's weight. If the Picker contains
@@ -50,13 +97,19 @@ func New[T any](items []T, weightFunc func(T) int) *Picker[T] {
 // behavior will result in an error from the random source; callers should
 // ensure at least one positive weight exists.
 func (p *Picker[T]) Pick() (T, error) {
+	return p.PickFrom(p.source())
+}
+
+// PickFrom is like Pick but draws its randomness from r for this call only,
+// regardless of the source the Picker was constructed with.
+func (p *Picker[T]) PickFrom(r io.Reader) (T, error) {
 	if len(p.items) == 0 {
 		var zero T
 		return zero, &ErrEmptyPicker{}
 	}
 
 	// Generate a random number between 1 and totalWeight
-	n, err := rand.Int(rand.Reader, big.NewInt(int64(p.totalWeight)))
+	n, err := rand.Int(r, big.NewInt(int64(p.totalWeight)))
 	if err != nil {
 		var zero T
 		return zero, err
@@ -67,6 +120,111 @@ func (p *Picker[T]) Pick() (T, error) {
 	return p.items[index], nil
 }
 
+// PickN returns n distinct items drawn without replacement, each draw being
+// proportional to the weights of the items not yet drawn.
+//
+// The Picker itself is not modified: PickN works on a temporary copy of the
+// prefix sums and subtracts each drawn item's weight from it before the next
+// draw, so an item can never be returned twice. Items are returned in draw
+// order. PickN returns an error if n is negative or exceeds the number of
+// items with a positive weight, and ErrEmptyPicker if the Picker contains no
+// items and n is positive.
+func (p *Picker[T]) PickN(n int) ([]T, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("randx: cannot pick a negative number of items: %d", n)
+	}
+	if n == 0 {
+		return []T{}, nil
+	}
+	if len(p.items) == 0 {
+		return nil, &ErrEmptyPicker{}
+	}
+
+	positive := 0
+	prev := 0
+	for _, sum := range p.prefixSums {
+		if sum > prev {
+			positive++
+		}
+		prev = sum
+	}
+	if n > positive {
+		return nil, fmt.Errorf("randx: cannot pick %d items, only %d have a positive weight", n, positive)
+	}
+
+	prefixSums := make([]int, len(p.prefixSums))
+	copy(prefixSums, p.prefixSums)
+	totalWeight := p.totalWeight
+
+	result := make([]T, 0, n)
+	for len(result) < n {
+		r, err := rand.Int(p.source(), big.NewInt(int64(totalWeight)))
+		if err != nil {
+			return nil, fmt.Errorf("randx: failed to generate random number: %w", err)
+		}
+		x := int(r.Int64()) + 1
+
+		index := sort.SearchInts(prefixSums, x)
+		result = append(result, p.items[index])
+
+		// Remove the drawn item's weight so it cannot be selected again.
+		weight := prefixSums[index]
+		if index > 0 {
+			weight -= prefixSums[index-1]
+		}
+		for i := index; i < len(prefixSums); i++ {
+			prefixSums[i] -= weight
+		}
+		totalWeight -= weight
+	}
+
+	return result, nil
+}
+
+// Weights returns the weight of each item, in item order, as computed by the
+// weightFunc at construction time.
+//
+// The weights are recovered from the internal prefix sums, so this is a cheap
+// way to check that a distribution was built as intended. The returned slice
+// is a fresh copy and may be modified freely.
+func (p *Picker[T]) Weights() []int {
+	weights := make([]int, len(p.prefixSums))
+	prev := 0
+	for i, sum := range p.prefixSums {
+		weights[i] = sum - prev
+		prev = sum
+	}
+	return weights
+}
+
+// TotalWeight returns the sum of all item weights.
+func (p *Picker[T]) TotalWeight() int {
+	return p.totalWeight
+}
+
+// Probability returns the probability that Pick selects the item at index.
+//
+// It returns 0 if index is out of range or if the total weight is not
+// positive.
+func (p *Picker[T]) Probability(index int) float64 {
+	if index < 0 || index >= len(p.prefixSums) || p.totalWeight <= 0 {
+		return 0
+	}
+	weight := p.prefixSums[index]
+	if index > 0 {
+		weight -= p.prefixSums[index-1]
+	}
+	return float64(weight) / float64(p.totalWeight)
+}
+
+// source returns the configured random source, defaulting to crypto/rand.
+func (p *Picker[T]) source() io.Reader {
+	if p.reader == nil {
+		return rand.Reader
+	}
+	return p.reader
+}
+
 // ErrEmptyPicker is returned when attempting to pick from an empty Picker.
 type ErrEmptyPicker struct{}
 
@@ -1,6 +1,7 @@
 package randx
 
 import (
+	"math/rand"
 	"testing"
 )
 
@@ -19,8 +20,8 @@ func TestPicker(t *testing.T) {
 		{value: 4, weight: 4},
 	}
 
-	// Create a new weighted random picker
-	picker := New(items, func(i item) int { return i.weight })
+	// Create a new weighted random picker with a seeded source so the test is deterministic
+	picker := NewWithReader(items, func(i item) int { return i.weight }, rand.New(rand.NewSource(1)))
 
 	counts := make(map[int]int)
 	const iterations = 1000000 // Number of iterations
@@ -51,6 +52,182 @@ func TestPicker(t *testing.T) {
 	}
 }
 
+// TestPickerPickFrom tests that a seeded source makes picks reproducible.
+func TestPickerPickFrom(t *testing.T) {
+	t.Parallel()
+
+	items := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	picker := New(items, func(i int) int { return i })
+
+	r1 := rand.New(rand.NewSource(42))
+	r2 := rand.New(rand.NewSource(42))
+	for i := 0; i < 50; i++ {
+		a, err := picker.PickFrom(r1)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		b, err := picker.PickFrom(r2)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if a != b {
+			t.Fatalf("Pick %d: expected identical picks from identical seeds, got %d and %d", i, a, b)
+		}
+	}
+
+	seeded := NewWithReader(items, func(i int) int { return i }, rand.New(rand.NewSource(7)))
+	replay := NewWithReader(items, func(i int) int { return i }, rand.New(rand.NewSource(7)))
+	first, _ := seeded.PickN(4)
+	second, _ := replay.PickN(4)
+	for i := range first {
+		if first[i] != second[i] {
+			t.Fatalf("Expected identical PickN results from identical seeds, got %v and %v", first, second)
+		}
+	}
+}
+
+// TestPickerPickN tests drawing without replacement.
+func TestPickerPickN(t *testing.T) {
+	t.Parallel()
+
+	weights := map[string]int{"a": 5, "b": 0, "c": 1, "d": 3}
+	items := []string{"a", "b", "c", "d"}
+	picker := New(items, func(s string) int { return weights[s] })
+
+	for i := 0; i < 100; i++ {
+		picked, err := picker.PickN(3)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(picked) != 3 {
+			t.Fatalf("Expected 3 items, got %d", len(picked))
+		}
+		seen := make(map[string]bool)
+		for _, item := range picked {
+			if item == "b" {
+				t.Fatalf("Zero-weight item was picked: %v", picked)
+			}
+			if seen[item] {
+				t.Fatalf("Item %q picked twice: %v", item, picked)
+			}
+			seen[item] = true
+		}
+	}
+
+	// The picker is unaffected by previous draws
+	if picker.totalWeight != 9 {
+		t.Errorf("Expected total weight to remain 9, got %d", picker.totalWeight)
+	}
+
+	if picked, err := picker.PickN(0); err != nil || len(picked) != 0 {
+		t.Errorf("Expected empty result for n=0, got %v, %v", picked, err)
+	}
+	if _, err := picker.PickN(4); err == nil {
+		t.Errorf("Expected error when n exceeds positive-weight items")
+	}
+	if _, err := picker.PickN(-1); err == nil {
+		t.Errorf("Expected error for negative n")
+	}
+	if _, err := New([]string{}, func(string) int { return 1 }).PickN(1); err == nil {
+		t.Errorf("Expected error for empty picker")
+	}
+}
+
+// TestPickerPickNDistribution tests the first draw follows the weights.
+func TestPickerPickNDistribution(t *testing.T) {
+	t.Parallel()
+
+	picker := New([]int{1, 3}, func(i int) int { return i })
+	const iterations = 20000
+
+	firstThree := 0
+	for i := 0; i < iterations; i++ {
+		picked, err := picker.PickN(2)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if picked[0] == 3 {
+			firstThree++
+		}
+	}
+
+	if actual := float64(firstThree) / iterations; abs(actual-0.75) > 0.02 {
+		t.Errorf("Expected first draw frequency 0.75 for weight 3, got %.4f", actual)
+	}
+}
+
+// TestPickerInspection tests the distribution accessors.
+func TestPickerInspection(t *testing.T) {
+	t.Parallel()
+
+	picker := New([]string{"a", "b", "c", "d"}, func(s string) int {
+		return map[string]int{"a": 1, "b": 0, "c": 3, "d": 4}[s]
+	})
+
+	weights := picker.Weights()
+	expected := []int{1, 0, 3, 4}
+	for i := range expected {
+		if weights[i] != expected[i] {
+			t.Errorf("Weights()[%d] = %d, want %d", i, weights[i], expected[i])
+		}
+	}
+	weights[0] = 100
+	if picker.Weights()[0] != 1 {
+		t.Errorf("Modifying Weights() result affected the picker")
+	}
+
+	if got := picker.TotalWeight(); got != 8 {
+		t.Errorf("TotalWeight() = %d, want 8", got)
+	}
+
+	probabilities := []float64{0.125, 0, 0.375, 0.5}
+	for i, want := range probabilities {
+		if got := picker.Probability(i); got != want {
+			t.Errorf("Probability(%d) = %v, want %v", i, got, want)
+		}
+	}
+	for _, i := range []int{-1, 4} {
+		if got := picker.Probability(i); got != 0 {
+			t.Errorf("Probability(%d) = %v, want 0", i, got)
+		}
+	}
+
+	empty := New([]string{}, func(string) int { return 1 })
+	if len(empty.Weights()) != 0 || empty.TotalWeight() != 0 || empty.Probability(0) != 0 {
+		t.Errorf("Expected empty picker to report no weights")
+	}
+}
+
+// TestNewChecked tests construction-time weight validation.
+func TestNewChecked(t *testing.T) {
+	t.Parallel()
+	identity := func(i int) int { return i }
+
+	picker, err := NewChecked([]int{0, 2, 3}, identity)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if picker.TotalWeight() != 5 {
+		t.Errorf("TotalWeight() = %d, want 5", picker.TotalWeight())
+	}
+
+	invalid := [][]int{{1, -1, 5}, {0, 0}, {}}
+	for _, items := range invalid {
+		if _, err := NewChecked(items, identity); err == nil {
+			t.Errorf("NewChecked(%v) expected an error", items)
+		}
+	}
+
+	calls := 0
+	counting := func(i int) int { calls++; return i }
+	if _, err := NewChecked([]int{1, 2, 3}, counting); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("weightFunc called %d times, want 3", calls)
+	}
+}
+
 func abs(x float64) float64 {
 	if x < 0 {
 		return -x
@@ -7,8 +7,10 @@ package randx
 
 import (
 	"crypto/rand"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"math"
 	"math/big"
 )
 
@@ -73,3 +75,86 @@ func RandIntRange[T Integer](min, max T) (T, error) {
 
 	return result, nil
 }
+
+// RandFloat64 returns a cryptographically secure random float64 uniformly
+// distributed in the half-open interval [0, 1).
+//
+// The value is built from 53 random bits, the full precision of a float64
+// mantissa, so every multiple of 2^-53 in the interval is equally likely and
+// 1.0 is never returned. An error is returned if the underlying random source
+// fails.
+func RandFloat64() (float64, error) {
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return 0, fmt.Errorf("randx: failed to generate random number: %w", err)
+	}
+	return float64(binary.BigEndian.Uint64(b[:])>>11) / (1 << 53), nil
+}
+
+// RandFloat64Range returns a cryptographically secure random float64 uniformly
+// distributed in the half-open interval [min, max).
+//
+// The function returns an error if min > max, if either bound is NaN or
+// infinite, or if the underlying random source fails. If min == max the
+// function returns min without consuming randomness. Ranges wider than the
+// largest float64 are supported without overflowing.
+func RandFloat64Range(min, max float64) (float64, error) {
+	if math.IsNaN(min) || math.IsNaN(max) || math.IsInf(min, 0) || math.IsInf(max, 0) {
+		return 0, errors.New("min and max must be finite numbers")
+	}
+	if min > max {
+		return 0, errors.New("min cannot be greater than max")
+	}
+	if min == max {
+		return min, nil
+	}
+
+	f, err := RandFloat64()
+	if err != nil {
+		return 0, err
+	}
+
+	// Interpolating avoids computing max-min, which can overflow to +Inf.
+	result := min*(1-f) + max*f
+	if result >= max {
+		// Rounding can land on the excluded upper bound.
+		result = math.Nextafter(max, min)
+	}
+	if result < min {
+		result = min
+	}
+	return result, nil
+}
+
+// RandBool returns a cryptographically secure random boolean with equal
+// probability of true and false.
+//
+// Only a single random byte is read and its lowest bit used. An error is
+// returned if the underlying random source fails.
+func RandBool() (bool, error) {
+	var b [1]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return false, fmt.Errorf("randx: failed to generate random number: %w", err)
+	}
+	return b[0]&1 == 1, nil
+}
+
+// RandBoolP returns true with probability p, using crypto/rand.
+//
+// p must be in the closed interval [0, 1]; otherwise (including NaN) an error
+// is returned. A p of 0 always yields false and a p of 1 always yields true,
+// without consuming randomness.
+func RandBoolP(p float64) (bool, error) {
+	if !(p >= 0 && p <= 1) {
+		return false, errors.New("p must be in the interval [0, 1]")
+	}
+	if p == 0 || p == 1 {
+		return p == 1, nil
+	}
+
+	f, err := RandFloat64()
+	if err != nil {
+		return false, err
+	}
+	return f < p, nil
+}
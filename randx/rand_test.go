@@ -1,6 +1,9 @@
 package randx
 
-import "testing"
+import (
+	"math"
+	"testing"
+)
 
 // testRange is a helper function to test a specific range for a given integer type.
 func testRange[T Integer](t *testing.T, min, max T) {
@@ -89,3 +92,97 @@ func TestRandIntRange(t *testing.T) {
 		testRange(t, -100, -10)
 	})
 }
+
+// TestRandFloat64 tests values stay in the half-open unit interval.
+func TestRandFloat64(t *testing.T) {
+	t.Parallel()
+	sum := 0.0
+	const iterations = 10000
+	for i := 0; i < iterations; i++ {
+		f, err := RandFloat64()
+		if err != nil {
+			t.Fatalf("RandFloat64() returned an error: %v", err)
+		}
+		if f < 0 || f >= 1 {
+			t.Fatalf("RandFloat64() returned %v, outside [0, 1)", f)
+		}
+		sum += f
+	}
+	if mean := sum / iterations; math.Abs(mean-0.5) > 0.02 {
+		t.Errorf("RandFloat64() mean = %.4f, want about 0.5", mean)
+	}
+}
+
+// TestRandFloat64Range tests bounds, degenerate ranges and invalid input.
+func TestRandFloat64Range(t *testing.T) {
+	t.Parallel()
+	ranges := [][2]float64{{-1, 1}, {10, 10.5}, {-math.MaxFloat64, math.MaxFloat64}, {0, math.SmallestNonzeroFloat64}}
+	for _, r := range ranges {
+		for i := 0; i < 1000; i++ {
+			f, err := RandFloat64Range(r[0], r[1])
+			if err != nil {
+				t.Fatalf("RandFloat64Range(%v, %v) returned an error: %v", r[0], r[1], err)
+			}
+			if f < r[0] || f >= r[1] || math.IsInf(f, 0) {
+				t.Fatalf("RandFloat64Range(%v, %v) returned %v, outside the interval", r[0], r[1], f)
+			}
+		}
+	}
+
+	if f, err := RandFloat64Range(3, 3); err != nil || f != 3 {
+		t.Errorf("RandFloat64Range(3, 3) = %v, %v, want 3 and nil", f, err)
+	}
+
+	invalid := [][2]float64{{2, 1}, {math.NaN(), 1}, {0, math.Inf(1)}}
+	for _, r := range invalid {
+		if _, err := RandFloat64Range(r[0], r[1]); err == nil {
+			t.Errorf("RandFloat64Range(%v, %v) expected an error", r[0], r[1])
+		}
+	}
+}
+
+// TestRandBool tests the fair and biased coin flips.
+func TestRandBool(t *testing.T) {
+	t.Parallel()
+	const iterations = 20000
+
+	heads := 0
+	biased := 0
+	for i := 0; i < iterations; i++ {
+		b, err := RandBool()
+		if err != nil {
+			t.Fatalf("RandBool() returned an error: %v", err)
+		}
+		if b {
+			heads++
+		}
+		b, err = RandBoolP(0.2)
+		if err != nil {
+			t.Fatalf("RandBoolP() returned an error: %v", err)
+		}
+		if b {
+			biased++
+		}
+	}
+
+	if freq := float64(heads) / iterations; math.Abs(freq-0.5) > 0.02 {
+		t.Errorf("RandBool() true frequency = %.4f, want about 0.5", freq)
+	}
+	if freq := float64(biased) / iterations; math.Abs(freq-0.2) > 0.02 {
+		t.Errorf("RandBoolP(0.2) true frequency = %.4f, want about 0.2", freq)
+	}
+
+	for _, p := range []float64{0, 1} {
+		for i := 0; i < 100; i++ {
+			if b, err := RandBoolP(p); err != nil || b != (p == 1) {
+				t.Fatalf("RandBoolP(%v) = %v, %v", p, b, err)
+			}
+		}
+	}
+
+	for _, p := range []float64{-0.1, 1.5, math.NaN()} {
+		if _, err := RandBoolP(p); err == nil {
+			t.Errorf("RandBoolP(%v) expected an error", p)
+		}
+	}
+}
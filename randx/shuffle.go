@@ -0,0 +1,62 @@
+package randx
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Shuffle randomizes the order of s in place with a Fisher-Yates shuffle whose
+// swap positions are drawn from crypto/rand via RandIntRange.
+//
+// Use it for security-sensitive orderings such as shuffling a deck or ballot
+// order, where a math/rand based shuffle would be predictable. If the
+// underlying random source fails, Shuffle returns the error immediately and s
+// is left partially shuffled; callers should discard it in that case.
+func Shuffle[T any](s []T) error {
+	for i := len(s) - 1; i > 0; i-- {
+		j, err := RandIntRange(0, i)
+		if err != nil {
+			return err
+		}
+		s[i], s[j] = s[j], s[i]
+	}
+	return nil
+}
+
+// SampleIndices returns k distinct indices chosen uniformly at random from
+// [0, population), using crypto/rand.
+//
+// It performs a partial Fisher-Yates shuffle over a virtual array of
+// population indices, recording only the swapped positions in a map, so it
+// uses O(k) time and memory regardless of population size. The indices are
+// returned in selection order, not sorted. The function returns an error if
+// either argument is negative, if k exceeds population, or if the underlying
+// random source fails.
+func SampleIndices(population, k int) ([]int, error) {
+	if population < 0 || k < 0 {
+		return nil, errors.New("population and k cannot be negative")
+	}
+	if k > population {
+		return nil, fmt.Errorf("randx: cannot sample %d indices from a population of %d", k, population)
+	}
+
+	// swapped[i] holds the value at virtual position i if it differs from i.
+	swapped := make(map[int]int, k)
+	at := func(i int) int {
+		if v, ok := swapped[i]; ok {
+			return v
+		}
+		return i
+	}
+
+	result := make([]int, k)
+	for i := 0; i < k; i++ {
+		j, err := RandIntRange(i, population-1)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = at(j)
+		swapped[j] = at(i)
+	}
+	return result, nil
+}
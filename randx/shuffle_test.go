@@ -0,0 +1,87 @@
+package randx
+
+import "testing"
+
+// TestShuffle tests the result is a permutation and every position is reachable.
+func TestShuffle(t *testing.T) {
+	t.Parallel()
+
+	s := []int{0, 1, 2, 3, 4}
+	firstCounts := make([]int, len(s))
+	const iterations = 5000
+	for i := 0; i < iterations; i++ {
+		if err := Shuffle(s); err != nil {
+			t.Fatalf("Shuffle() returned an error: %v", err)
+		}
+		seen := make([]bool, len(s))
+		for _, v := range s {
+			if seen[v] {
+				t.Fatalf("Shuffle() produced a duplicate: %v", s)
+			}
+			seen[v] = true
+		}
+		firstCounts[s[0]]++
+	}
+
+	for v, count := range firstCounts {
+		if freq := float64(count) / iterations; abs(freq-0.2) > 0.03 {
+			t.Errorf("Value %d: expected first-position frequency 0.2000, got %.4f", v, freq)
+		}
+	}
+
+	if err := Shuffle([]int(nil)); err != nil {
+		t.Errorf("Shuffle(nil) returned an error: %v", err)
+	}
+}
+
+// TestSampleIndices tests distinctness, bounds, uniformity and argument checks.
+func TestSampleIndices(t *testing.T) {
+	t.Parallel()
+
+	const population = 10
+	counts := make([]int, population)
+	const iterations = 5000
+	for i := 0; i < iterations; i++ {
+		indices, err := SampleIndices(population, 3)
+		if err != nil {
+			t.Fatalf("SampleIndices() returned an error: %v", err)
+		}
+		if len(indices) != 3 {
+			t.Fatalf("SampleIndices() returned %d indices, want 3", len(indices))
+		}
+		seen := make(map[int]bool)
+		for _, idx := range indices {
+			if idx < 0 || idx >= population {
+				t.Fatalf("SampleIndices() returned %d, outside [0, %d)", idx, population)
+			}
+			if seen[idx] {
+				t.Fatalf("SampleIndices() returned a duplicate: %v", indices)
+			}
+			seen[idx] = true
+			counts[idx]++
+		}
+	}
+
+	for idx, count := range counts {
+		if freq := float64(count) / iterations; abs(freq-0.3) > 0.03 {
+			t.Errorf("Index %d: expected inclusion frequency 0.3000, got %.4f", idx, freq)
+		}
+	}
+
+	all, err := SampleIndices(5, 5)
+	if err != nil || len(all) != 5 {
+		t.Errorf("SampleIndices(5, 5) = %v, %v, want a permutation of 0..4", all, err)
+	}
+	if got, err := SampleIndices(1<<40, 2); err != nil || len(got) != 2 {
+		t.Errorf("SampleIndices() on a huge population = %v, %v", got, err)
+	}
+	if got, err := SampleIndices(0, 0); err != nil || len(got) != 0 {
+		t.Errorf("SampleIndices(0, 0) = %v, %v, want empty and nil", got, err)
+	}
+
+	for _, args := range [][2]int{{3, 4}, {-1, 0}, {3, -1}} {
+		if _, err := SampleIndices(args[0], args[1]); err == nil {
+			t.Errorf("SampleIndices(%d, %d) expected an error", args[0], args[1])
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package randx
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+)
+
+// base62Alphabet is the default alphabet used by RandString.
+const base62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// RandBytes returns n cryptographically secure random bytes.
+//
+// The function returns an error if n is negative or if the underlying random
+// source fails. A zero n returns an empty, non-nil slice.
+func RandBytes(n int) ([]byte, error) {
+	if n < 0 {
+		return nil, errors.New("n cannot be negative")
+	}
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return nil, fmt.Errorf("randx: failed to generate random number: %w", err)
+	}
+	return b, nil
+}
+
+// RandString returns a cryptographically secure random string of n characters
+// drawn uniformly from alphabet.
+//
+// An empty alphabet selects the base62 alphabet [0-9A-Za-z]. The alphabet is
+// interpreted as a sequence of runes, so multi-byte characters are supported.
+// Characters are chosen by rejection sampling: random bytes that would map
+// unevenly onto the alphabet are discarded, so there is no modulo bias. The
+// function returns an error if n is negative or if the underlying random
+// source fails.
+func RandString(n int, alphabet string) (string, error) {
+	if n < 0 {
+		return "", errors.New("n cannot be negative")
+	}
+	if alphabet == "" {
+		alphabet = base62Alphabet
+	}
+	chars := []rune(alphabet)
+	size := len(chars)
+
+	result := make([]rune, 0, n)
+	if size > 256 {
+		// A single byte cannot cover the alphabet; draw each index directly.
+		for len(result) < n {
+			i, err := RandIntRange(0, size-1)
+			if err != nil {
+				return "", err
+			}
+			result = append(result, chars[i])
+		}
+		return string(result), nil
+	}
+
+	// Bytes at or above limit are rejected so every character is equally likely.
+	limit := 256 - 256%size
+	buf := make([]byte, n+n/4+1)
+	for len(result) < n {
+		if _, err := rand.Read(buf); err != nil {
+			return "", fmt.Errorf("randx: failed to generate random number: %w", err)
+		}
+		for _, b := range buf {
+			if int(b) >= limit {
+				continue
+			}
+			result = append(result, chars[int(b)%size])
+			if len(result) == n {
+				break
+			}
+		}
+	}
+	return string(result), nil
+}
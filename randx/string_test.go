@@ -0,0 +1,69 @@
+package randx
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// TestRandBytes tests the requested number of bytes is returned.
+func TestRandBytes(t *testing.T) {
+	t.Parallel()
+	for _, n := range []int{0, 1, 32} {
+		b, err := RandBytes(n)
+		if err != nil {
+			t.Fatalf("RandBytes(%d) returned an error: %v", n, err)
+		}
+		if b == nil || len(b) != n {
+			t.Errorf("RandBytes(%d) returned %d bytes", n, len(b))
+		}
+	}
+	if _, err := RandBytes(-1); err == nil {
+		t.Errorf("RandBytes(-1) expected an error")
+	}
+}
+
+// TestRandString tests length, alphabet membership and uniformity.
+func TestRandString(t *testing.T) {
+	t.Parallel()
+
+	s, err := RandString(64, "")
+	if err != nil {
+		t.Fatalf("RandString() returned an error: %v", err)
+	}
+	if len(s) != 64 {
+		t.Errorf("RandString() length = %d, want 64", len(s))
+	}
+	for _, c := range s {
+		if !strings.ContainsRune(base62Alphabet, c) {
+			t.Errorf("RandString() produced %q outside the base62 alphabet", c)
+		}
+	}
+
+	s, err = RandString(10, "αβγ")
+	if err != nil {
+		t.Fatalf("RandString() returned an error: %v", err)
+	}
+	if utf8.RuneCountInString(s) != 10 || strings.Trim(s, "αβγ") != "" {
+		t.Errorf("RandString() = %q, want 10 runes from αβγ", s)
+	}
+
+	// 256 % 3 != 0, so a biased implementation would favor the first characters.
+	s, err = RandString(30000, "abc")
+	if err != nil {
+		t.Fatalf("RandString() returned an error: %v", err)
+	}
+	for _, c := range "abc" {
+		freq := float64(strings.Count(s, string(c))) / 30000
+		if abs(freq-1.0/3) > 0.02 {
+			t.Errorf("Character %q: expected frequency 0.3333, got %.4f", c, freq)
+		}
+	}
+
+	if s, err := RandString(0, "ab"); err != nil || s != "" {
+		t.Errorf("RandString(0) = %q, %v, want empty string", s, err)
+	}
+	if _, err := RandString(-1, ""); err == nil {
+		t.Errorf("RandString(-1) expected an error")
+	}
+}
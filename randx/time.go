@@ -0,0 +1,86 @@
+package randx
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+)
+
+// RandTime returns a cryptographically secure random instant uniformly
+// distributed in the half-open interval [start, end).
+//
+// The span is computed with math/big from the Unix seconds and nanoseconds of
+// both bounds, so ranges wider than time.Duration can represent (~292 years)
+// are supported. The result uses the location of start. If start equals end
+// the function returns start without consuming randomness; if end is before
+// start it returns an error.
+func RandTime(start, end time.Time) (time.Time, error) {
+	if end.Before(start) {
+		return time.Time{}, errors.New("start cannot be after end")
+	}
+
+	if start.Equal(end) {
+		return start, nil
+	}
+
+	nanosPerSecond := big.NewInt(int64(time.Second))
+
+	span := big.NewInt(end.Unix() - start.Unix())
+	span.Mul(span, nanosPerSecond)
+	span.Add(span, big.NewInt(int64(end.Nanosecond()-start.Nanosecond())))
+
+	offset, err := rand.Int(rand.Reader, span)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("randx: failed to generate random number: %w", err)
+	}
+
+	seconds, nanos := new(big.Int).QuoRem(offset, nanosPerSecond, new(big.Int))
+	result := time.Unix(start.Unix()+seconds.Int64(), int64(start.Nanosecond())+nanos.Int64())
+
+	return result.In(start.Location()), nil
+}
+
+// Jitter returns a cryptographically secure random duration uniformly
+// distributed in the closed interval [base*(1-factor), base*(1+factor)].
+//
+// The lower bound is clamped at zero and the upper bound at the maximum
+// representable time.Duration. A factor of zero returns base unchanged. The
+// bounds are computed exactly with math/big rather than in float64, so bases
+// above 2^53 and bases near the maximum duration are handled precisely. The
+// function returns an error if factor is negative or NaN, or if the underlying
+// random source fails.
+func Jitter(base time.Duration, factor float64) (time.Duration, error) {
+	if factor < 0 || math.IsNaN(factor) {
+		return 0, errors.New("factor must be a non-negative number")
+	}
+	if factor == 0 {
+		return base, nil
+	}
+
+	// |base| * factor is exact at 128 bits: a 64-bit integer times a 53-bit mantissa.
+	b := big.NewInt(int64(base))
+	product := new(big.Float).SetPrec(128).SetInt(new(big.Int).Abs(b))
+	product.Mul(product, new(big.Float).SetFloat64(factor))
+	delta, _ := product.Int(nil)
+
+	lo := new(big.Int).Sub(b, delta)
+	if lo.Sign() < 0 {
+		lo.SetInt64(0)
+	}
+	hi := new(big.Int).Add(b, delta)
+	if maxDuration := big.NewInt(math.MaxInt64); hi.Cmp(maxDuration) > 0 {
+		hi = maxDuration
+	}
+	if lo.Cmp(hi) > 0 {
+		return 0, nil
+	}
+
+	n, err := RandIntRange(lo.Int64(), hi.Int64())
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(n), nil
+}
@@ -0,0 +1,121 @@
+package randx
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestRandTime(t *testing.T) {
+	t.Run("within range", func(t *testing.T) {
+		start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+		end := start.Add(time.Hour)
+		for i := 0; i < 100; i++ {
+			got, err := RandTime(start, end)
+			if err != nil {
+				t.Fatalf("RandTime returned an error: %v", err)
+			}
+			if got.Before(start) || !got.Before(end) {
+				t.Errorf("RandTime(%v, %v) returned %v, which is outside [start, end)", start, end, got)
+			}
+		}
+	})
+
+	t.Run("wide range", func(t *testing.T) {
+		start := time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)
+		end := time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC)
+		for i := 0; i < 100; i++ {
+			got, err := RandTime(start, end)
+			if err != nil {
+				t.Fatalf("RandTime returned an error: %v", err)
+			}
+			if got.Before(start) || !got.Before(end) {
+				t.Errorf("RandTime(%v, %v) returned %v, which is outside [start, end)", start, end, got)
+			}
+		}
+	})
+
+	t.Run("equal bounds", func(t *testing.T) {
+		start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+		got, err := RandTime(start, start)
+		if err != nil || !got.Equal(start) {
+			t.Errorf("Expected %v and nil, got %v and %v", start, got, err)
+		}
+	})
+
+	t.Run("invalid range", func(t *testing.T) {
+		start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+		if _, err := RandTime(start, start.Add(-time.Second)); err == nil {
+			t.Errorf("RandTime should have returned an error for end before start")
+		}
+	})
+}
+
+func TestJitter(t *testing.T) {
+	t.Run("within bounds", func(t *testing.T) {
+		base := time.Second
+		for i := 0; i < 100; i++ {
+			got, err := Jitter(base, 0.2)
+			if err != nil {
+				t.Fatalf("Jitter returned an error: %v", err)
+			}
+			if got < 800*time.Millisecond || got > 1200*time.Millisecond {
+				t.Errorf("Jitter(%v, 0.2) returned %v, which is outside the valid range", base, got)
+			}
+		}
+	})
+
+	t.Run("clamped at zero", func(t *testing.T) {
+		for i := 0; i < 100; i++ {
+			got, err := Jitter(time.Second, 3)
+			if err != nil {
+				t.Fatalf("Jitter returned an error: %v", err)
+			}
+			if got < 0 || got > 4*time.Second {
+				t.Errorf("Jitter(1s, 3) returned %v, which is outside the valid range", got)
+			}
+		}
+	})
+
+	t.Run("zero factor", func(t *testing.T) {
+		got, err := Jitter(time.Second, 0)
+		if err != nil || got != time.Second {
+			t.Errorf("Expected 1s and nil, got %v and %v", got, err)
+		}
+
+		// Above 2^53 float64 cannot represent every integer, so base must not round-trip through it
+		base := time.Duration(1<<53 + 1)
+		if got, err := Jitter(base, 0); err != nil || got != base {
+			t.Errorf("Expected %d and nil, got %d and %v", base, got, err)
+		}
+	})
+
+	t.Run("large base", func(t *testing.T) {
+		if _, err := Jitter(time.Duration(math.MaxInt64), 0.5); err != nil {
+			t.Errorf("Jitter returned an error: %v", err)
+		}
+	})
+
+	t.Run("maximum base with small factor", func(t *testing.T) {
+		base := time.Duration(math.MaxInt64)
+		lower := base - time.Duration(float64(base)*1e-9)
+		for i := 0; i < 100; i++ {
+			got, err := Jitter(base, 1e-9)
+			if err != nil {
+				t.Fatalf("Jitter returned an error: %v", err)
+			}
+			if got < lower {
+				t.Errorf("Jitter(MaxInt64, 1e-9) returned %d, below %d", got, lower)
+			}
+		}
+	})
+
+	t.Run("invalid factor", func(t *testing.T) {
+		if _, err := Jitter(time.Second, -0.1); err == nil {
+			t.Errorf("Jitter should have returned an error for negative factor")
+		}
+		if _, err := Jitter(time.Second, math.NaN()); err == nil {
+			t.Errorf("Jitter should have returned an error for NaN factor")
+		}
+	})
+}
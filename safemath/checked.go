@@ -0,0 +1,55 @@
+package safemath
+
+// Checked accumulates a chain of checked integer operations and records the first error.
+//
+// Once an operation fails, the error is sticky: later operations are skipped and the
+// value is left unchanged, so a whole computation can be chained and checked once:
+//
+//	var c safemath.Checked[int64]
+//	result, err := c.Add(x).Mul(y).Sub(z).Result()
+//
+// The zero value is ready to use and starts at zero with no error.
+// A Checked is not safe for concurrent use.
+type Checked[T Integer] struct {
+	value T
+	err   error
+}
+
+// NewChecked returns a Checked accumulator starting at initial.
+func NewChecked[T Integer](initial T) *Checked[T] {
+	return &Checked[T]{value: initial}
+}
+
+// Add adds x to the accumulated value unless an earlier operation failed.
+func (c *Checked[T]) Add(x T) *Checked[T] {
+	if c.err == nil {
+		c.value, c.err = Add(c.value, x)
+	}
+	return c
+}
+
+// Sub subtracts x from the accumulated value unless an earlier operation failed.
+func (c *Checked[T]) Sub(x T) *Checked[T] {
+	if c.err == nil {
+		c.value, c.err = Sub(c.value, x)
+	}
+	return c
+}
+
+// Mul multiplies the accumulated value by x unless an earlier operation failed.
+func (c *Checked[T]) Mul(x T) *Checked[T] {
+	if c.err == nil {
+		c.value, c.err = Mul(c.value, x)
+	}
+	return c
+}
+
+// Result returns the accumulated value and the first error encountered, if any.
+// When an error is returned the value is the zero value of T.
+func (c *Checked[T]) Result() (T, error) {
+	if c.err != nil {
+		var zero T
+		return zero, c.err
+	}
+	return c.value, nil
+}
@@ -0,0 +1,43 @@
+package safemath
+
+import (
+	"math"
+	"testing"
+)
+
+// TestChecked tests chained operations on the Checked accumulator
+func TestChecked(t *testing.T) {
+	t.Run("zero value", func(t *testing.T) {
+		var c Checked[int64]
+		got, err := c.Add(10).Mul(3).Sub(5).Result()
+		if err != nil || got != 25 {
+			t.Errorf("Result() = %v, %v, want 25, nil", got, err)
+		}
+	})
+
+	t.Run("initial value", func(t *testing.T) {
+		got, err := NewChecked(int32(7)).Mul(6).Result()
+		if err != nil || got != 42 {
+			t.Errorf("Result() = %v, %v, want 42, nil", got, err)
+		}
+	})
+
+	t.Run("sticky error", func(t *testing.T) {
+		c := NewChecked(int64(math.MaxInt64))
+		c.Add(1)
+		// Would bring the value back into range if it were applied
+		c.Sub(10)
+		got, err := c.Result()
+		if err != ErrOverflow || got != 0 {
+			t.Errorf("Result() = %v, %v, want 0, %v", got, err, ErrOverflow)
+		}
+	})
+
+	t.Run("first error wins", func(t *testing.T) {
+		var c Checked[uint8]
+		_, err := c.Sub(1).Add(255).Add(1).Result()
+		if err != ErrUnderflow {
+			t.Errorf("Result() error = %v, want %v", err, ErrUnderflow)
+		}
+	})
+}
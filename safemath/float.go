@@ -0,0 +1,69 @@
+package safemath
+
+import "math"
+
+// SumFloat returns the sum of xs using compensated (Kahan–Babuška) summation,
+// which keeps the rounding error independent of the number of values.
+// It returns ErrNaN if any input is NaN or the sum is NaN (e.g., +Inf + -Inf),
+// ErrOverflow if the sum is +Inf, and ErrUnderflow if the sum is -Inf.
+// An empty slice sums to zero.
+func SumFloat(xs []float64) (float64, error) {
+	var sum, compensation float64
+	for _, x := range xs {
+		t := sum + x
+		if math.Abs(sum) >= math.Abs(x) {
+			compensation += (sum - t) + x
+		} else {
+			compensation += (x - t) + sum
+		}
+		sum = t
+	}
+
+	// Inspect the running sum rather than the compensated result: once the sum
+	// reaches ±Inf the compensation term degenerates to NaN.
+	switch {
+	case math.IsNaN(sum):
+		return 0, ErrNaN
+	case math.IsInf(sum, 1):
+		return 0, ErrOverflow
+	case math.IsInf(sum, -1):
+		return 0, ErrUnderflow
+	}
+	return sum + compensation, nil
+}
+
+// MaxFloat returns the greater of a or b.
+// Unlike Max, it propagates NaN: if either argument is NaN the result is NaN.
+// It also orders signed zeros, so MaxFloat(-0, +0) is +0.
+func MaxFloat(a, b float64) float64 {
+	switch {
+	case math.IsNaN(a) || math.IsNaN(b):
+		return math.NaN()
+	case a == 0 && b == 0:
+		if math.Signbit(a) {
+			return b
+		}
+		return a
+	case a > b:
+		return a
+	}
+	return b
+}
+
+// MinFloat returns the lesser of a or b.
+// Unlike Min, it propagates NaN: if either argument is NaN the result is NaN.
+// It also orders signed zeros, so MinFloat(-0, +0) is -0.
+func MinFloat(a, b float64) float64 {
+	switch {
+	case math.IsNaN(a) || math.IsNaN(b):
+		return math.NaN()
+	case a == 0 && b == 0:
+		if math.Signbit(a) {
+			return a
+		}
+		return b
+	case a < b:
+		return a
+	}
+	return b
+}
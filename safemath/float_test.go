@@ -0,0 +1,75 @@
+package safemath
+
+import (
+	"math"
+	"testing"
+)
+
+// TestSumFloat tests the SumFloat function
+func TestSumFloat(t *testing.T) {
+	tests := []struct {
+		name    string
+		xs      []float64
+		want    float64
+		wantErr error
+	}{
+		{name: "empty", xs: nil, want: 0, wantErr: nil},
+		{name: "simple", xs: []float64{1.5, 2.5, -1}, want: 3, wantErr: nil},
+		{name: "compensated", xs: []float64{1, 1e100, 1, -1e100}, want: 2, wantErr: nil},
+		{name: "overflow", xs: []float64{math.MaxFloat64, math.MaxFloat64}, want: 0, wantErr: ErrOverflow},
+		{name: "underflow", xs: []float64{-math.MaxFloat64, -math.MaxFloat64}, want: 0, wantErr: ErrUnderflow},
+		{name: "nan input", xs: []float64{1, math.NaN()}, want: 0, wantErr: ErrNaN},
+		{name: "opposite infinities", xs: []float64{math.Inf(1), math.Inf(-1)}, want: 0, wantErr: ErrNaN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SumFloat(tt.xs)
+			if err != tt.wantErr {
+				t.Errorf("SumFloat() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("SumFloat() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestMinMaxFloat tests the MinFloat and MaxFloat functions
+func TestMinMaxFloat(t *testing.T) {
+	negZero := math.Copysign(0, -1)
+	tests := []struct {
+		name    string
+		a       float64
+		b       float64
+		wantMin float64
+		wantMax float64
+	}{
+		{name: "ordered", a: 1, b: 2, wantMin: 1, wantMax: 2},
+		{name: "reversed", a: 2, b: 1, wantMin: 1, wantMax: 2},
+		{name: "infinities", a: math.Inf(-1), b: math.Inf(1), wantMin: math.Inf(-1), wantMax: math.Inf(1)},
+		{name: "nan first", a: math.NaN(), b: 1, wantMin: math.NaN(), wantMax: math.NaN()},
+		{name: "nan second", a: 1, b: math.NaN(), wantMin: math.NaN(), wantMax: math.NaN()},
+		{name: "signed zeros", a: 0, b: negZero, wantMin: negZero, wantMax: 0},
+		{name: "signed zeros reversed", a: negZero, b: 0, wantMin: negZero, wantMax: 0},
+	}
+
+	same := func(a, b float64) bool {
+		if math.IsNaN(a) || math.IsNaN(b) {
+			return math.IsNaN(a) && math.IsNaN(b)
+		}
+		return a == b && math.Signbit(a) == math.Signbit(b)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MinFloat(tt.a, tt.b); !same(got, tt.wantMin) {
+				t.Errorf("MinFloat() = %v, want %v", got, tt.wantMin)
+			}
+			if got := MaxFloat(tt.a, tt.b); !same(got, tt.wantMax) {
+				t.Errorf("MaxFloat() = %v, want %v", got, tt.wantMax)
+			}
+		})
+	}
+}
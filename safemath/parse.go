@@ -0,0 +1,63 @@
+package safemath
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseClamped parses s as a base-10 integer of type T.
+// It returns ErrOverflow or ErrUnderflow if the number is well-formed but does not fit in T
+// (including any negative number for unsigned T), and a wrapped *strconv.NumError if s is
+// malformed. A leading '+' or '-' sign is accepted.
+func ParseClamped[T Integer](s string) (T, error) {
+	var zero T
+
+	// Unsigned types, including named ones, have a minimum of zero.
+	if minValue[T]() == 0 {
+		if strings.HasPrefix(s, "-") {
+			// Distinguish a negative number (out of range) from a malformed string.
+			v, err := strconv.ParseInt(s, 10, 64)
+			switch {
+			case err == nil && v == 0:
+				return zero, nil
+			case err == nil || errors.Is(err, strconv.ErrRange):
+				return zero, ErrUnderflow
+			default:
+				return zero, fmt.Errorf("safemath: %w", err)
+			}
+		}
+		// ParseUint does not accept an explicit plus sign.
+		digits := strings.TrimPrefix(s, "+")
+		v, err := strconv.ParseUint(digits, 10, 64)
+		if err != nil {
+			if errors.Is(err, strconv.ErrRange) {
+				return zero, ErrOverflow
+			}
+			return zero, fmt.Errorf("safemath: %w", err)
+		}
+		if v > uint64(maxValue[T]()) {
+			return zero, ErrOverflow
+		}
+		return T(v), nil
+	}
+
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		if errors.Is(err, strconv.ErrRange) {
+			if strings.HasPrefix(s, "-") {
+				return zero, ErrUnderflow
+			}
+			return zero, ErrOverflow
+		}
+		return zero, fmt.Errorf("safemath: %w", err)
+	}
+	if v > int64(maxValue[T]()) {
+		return zero, ErrOverflow
+	}
+	if v < int64(minValue[T]()) {
+		return zero, ErrUnderflow
+	}
+	return T(v), nil
+}
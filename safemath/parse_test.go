@@ -0,0 +1,115 @@
+package safemath
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+)
+
+// TestParseClampedSigned tests ParseClamped with a signed target type
+func TestParseClampedSigned(t *testing.T) {
+	tests := []struct {
+		name      string
+		s         string
+		want      int8
+		wantErr   error
+		malformed bool
+	}{
+		{name: "positive", s: "42", want: 42},
+		{name: "leading plus", s: "+42", want: 42},
+		{name: "negative", s: "-42", want: -42},
+		{name: "exact max", s: "127", want: 127},
+		{name: "exact min", s: "-128", want: -128},
+		{name: "above max", s: "128", wantErr: ErrOverflow},
+		{name: "below min", s: "-129", wantErr: ErrUnderflow},
+		{name: "beyond int64", s: "99999999999999999999", wantErr: ErrOverflow},
+		{name: "beyond int64 negative", s: "-99999999999999999999", wantErr: ErrUnderflow},
+		{name: "malformed", s: "12a", malformed: true},
+		{name: "empty", s: "", malformed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseClamped[int8](tt.s)
+			if tt.malformed {
+				var numErr *strconv.NumError
+				if !errors.As(err, &numErr) {
+					t.Errorf("ParseClamped() error = %v, want *strconv.NumError", err)
+				}
+				return
+			}
+			if err != tt.wantErr {
+				t.Errorf("ParseClamped() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("ParseClamped() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestParseClampedUnsigned tests ParseClamped with an unsigned target type
+func TestParseClampedUnsigned(t *testing.T) {
+	tests := []struct {
+		name      string
+		s         string
+		want      uint16
+		wantErr   error
+		malformed bool
+	}{
+		{name: "positive", s: "42", want: 42},
+		{name: "leading plus", s: "+42", want: 42},
+		{name: "exact max", s: "65535", want: 65535},
+		{name: "negative zero", s: "-0", want: 0},
+		{name: "above max", s: "65536", wantErr: ErrOverflow},
+		{name: "beyond uint64", s: "99999999999999999999", wantErr: ErrOverflow},
+		{name: "negative", s: "-1", wantErr: ErrUnderflow},
+		{name: "large negative", s: "-99999999999999999999", wantErr: ErrUnderflow},
+		{name: "malformed negative", s: "-x", malformed: true},
+		{name: "double plus", s: "++1", malformed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseClamped[uint16](tt.s)
+			if tt.malformed {
+				var numErr *strconv.NumError
+				if !errors.As(err, &numErr) {
+					t.Errorf("ParseClamped() error = %v, want *strconv.NumError", err)
+				}
+				return
+			}
+			if err != tt.wantErr {
+				t.Errorf("ParseClamped() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("ParseClamped() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestParseClampedNamedTypes tests that signedness is detected for named target types
+func TestParseClampedNamedTypes(t *testing.T) {
+	type ID uint64
+	type Temp int16
+
+	if got, err := ParseClamped[ID]("5"); err != nil || got != 5 {
+		t.Errorf("ParseClamped[ID](\"5\") = %v, %v, want 5, nil", got, err)
+	}
+	if got, err := ParseClamped[ID]("18446744073709551615"); err != nil || got != math.MaxUint64 {
+		t.Errorf("ParseClamped[ID]() = %v, %v, want %v, nil", got, err, uint64(math.MaxUint64))
+	}
+	if _, err := ParseClamped[ID]("-1"); err != ErrUnderflow {
+		t.Errorf("ParseClamped[ID](\"-1\") error = %v, want %v", err, ErrUnderflow)
+	}
+	if got, err := ParseClamped[Temp]("-32768"); err != nil || got != math.MinInt16 {
+		t.Errorf("ParseClamped[Temp]() = %v, %v, want %v, nil", got, err, math.MinInt16)
+	}
+	if _, err := ParseClamped[Temp]("32768"); err != ErrOverflow {
+		t.Errorf("ParseClamped[Temp](\"32768\") error = %v, want %v", err, ErrOverflow)
+	}
+}
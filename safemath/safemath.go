@@ -5,8 +5,10 @@ package safemath
 import (
 	"cmp"
 	"errors"
-	"math"
+	"fmt"
 	"math/bits"
+	"slices"
+	"unsafe"
 )
 
 var (
@@ -19,6 +21,9 @@ var (
 
 	// ErrDivisionByZero is returned when division or modulo by zero is attempted.
 	ErrDivisionByZero = errors.New("safemath: division by zero")
+
+	// ErrNaN is returned when a floating-point operation produces NaN.
+	ErrNaN = errors.New("safemath: result is NaN")
 )
 
 // Signed is a type constraint for all signed integer types.
@@ -132,6 +137,34 @@ func Div[T Integer](a, b T) (T, error) {
 	return a / b, nil
 }
 
+// FloorDiv returns a / b rounded toward negative infinity (e.g., FloorDiv(-7, 2) == -4).
+// It returns ErrDivisionByZero if b is zero and ErrOverflow for math.MinInt / -1.
+func FloorDiv[T Signed](a, b T) (T, error) {
+	q, r, err := DivMod(a, b)
+	if err != nil {
+		return q, err
+	}
+	// Truncation rounded up when the remainder and divisor have opposite signs.
+	if r != 0 && (r < 0) != (b < 0) {
+		q--
+	}
+	return q, nil
+}
+
+// CeilDiv returns a / b rounded toward positive infinity (e.g., CeilDiv(10, 3) == 4).
+// It returns ErrDivisionByZero if b is zero and ErrOverflow for math.MinInt / -1.
+func CeilDiv[T Integer](a, b T) (T, error) {
+	q, r, err := DivMod(a, b)
+	if err != nil {
+		return q, err
+	}
+	// Truncation rounded down when the remainder and divisor have the same sign.
+	if r != 0 && (r > 0) == (b > 0) {
+		q++
+	}
+	return q, nil
+}
+
 // Mod returns a % b if b is nonzero.
 func Mod[T Integer](a, b T) (T, error) {
 	var zero T
@@ -141,6 +174,23 @@ func Mod[T Integer](a, b T) (T, error) {
 	return a % b, nil
 }
 
+// DivMod returns the quotient a / b and remainder a % b with a single validation pass.
+// It follows Go's truncated division, so the remainder has the sign of a.
+// It returns ErrDivisionByZero if b is zero and ErrOverflow for math.MinInt / -1.
+func DivMod[T Integer](a, b T) (q T, r T, err error) {
+	var zero T
+	if b == 0 {
+		return zero, zero, ErrDivisionByZero
+	}
+
+	// MinInt / -1 overflows; see Div. A negative minimum identifies signed T, including named types.
+	if minimum := minValue[T](); minimum < 0 && a == minimum && b == zero-1 {
+		return zero, zero, ErrOverflow
+	}
+
+	return a / b, a % b, nil
+}
+
 // MulU64 uses bits.Mul64 to perform overflow-checked multiplication of uint64 values.
 func MulU64(a, b uint64) (uint64, error) {
 	hi, lo := bits.Mul64(a, b)
@@ -168,6 +218,104 @@ func SubU64(a, b uint64) (uint64, error) {
 	return diff, nil
 }
 
+// Sum returns the sum of values, checking every step for overflow or underflow.
+// On failure the returned error wraps ErrOverflow or ErrUnderflow (use errors.Is)
+// and reports the index of the value at which the sum went out of range.
+// An empty slice sums to zero.
+func Sum[T Integer](values []T) (T, error) {
+	var sum T
+	for i, v := range values {
+		next, err := Add(sum, v)
+		if err != nil {
+			var zero T
+			return zero, fmt.Errorf("safemath: sum at index %d: %w", i, err)
+		}
+		sum = next
+	}
+	return sum, nil
+}
+
+// AddCarry returns a + b + carryIn along with the carry out of the addition.
+// It generalizes bits.Add64 to any unsigned width for multi-word arithmetic:
+// carryIn must be 0 or 1 (any nonzero value is treated as 1), and carryOut is always 0 or 1.
+func AddCarry[T Unsigned](a, b, carryIn T) (sum, carryOut T) {
+	if carryIn != 0 {
+		carryIn = 1
+	}
+	sum = a + b + carryIn
+	if sum < a || (sum == a && carryIn != 0) {
+		carryOut = 1
+	}
+	return sum, carryOut
+}
+
+// SubBorrow returns a - b - borrowIn along with the borrow out of the subtraction.
+// It generalizes bits.Sub64 to any unsigned width for multi-word arithmetic:
+// borrowIn must be 0 or 1 (any nonzero value is treated as 1), and borrowOut is always 0 or 1.
+func SubBorrow[T Unsigned](a, b, borrowIn T) (diff, borrowOut T) {
+	if borrowIn != 0 {
+		borrowIn = 1
+	}
+	diff = a - b - borrowIn
+	if diff > a || (diff == a && borrowIn != 0) {
+		borrowOut = 1
+	}
+	return diff, borrowOut
+}
+
+// MulDiv returns a * b / c, truncated toward zero, using a 128-bit intermediate product
+// so that it succeeds whenever the final result fits in T (e.g., MulDiv(math.MaxInt64, 3, 4)).
+// It returns ErrDivisionByZero if c is zero, and ErrOverflow or ErrUnderflow only when
+// the true result is out of range for T.
+func MulDiv[T Integer](a, b, c T) (T, error) {
+	var zero T
+	if c == 0 {
+		return zero, ErrDivisionByZero
+	}
+
+	// Work on magnitudes in uint64, which holds |x| for every integer type.
+	ua, negA := absU64(a)
+	ub, negB := absU64(b)
+	uc, negC := absU64(c)
+	negative := negA != negB != negC
+
+	hi, lo := bits.Mul64(ua, ub)
+	if hi >= uc {
+		// The quotient needs more than 64 bits.
+		if negative {
+			return zero, ErrUnderflow
+		}
+		return zero, ErrOverflow
+	}
+	q, _ := bits.Div64(hi, lo, uc)
+
+	limit := uint64(maxValue[T]())
+	if negative {
+		if q == 0 {
+			return zero, nil
+		}
+		// Signed types reach one further below zero than above; unsigned cannot go below zero.
+		if minValue[T]() == 0 || q > limit+1 {
+			return zero, ErrUnderflow
+		}
+		// For q == limit+1 the conversion wraps to math.MinInt, whose negation is itself.
+		return -T(q), nil
+	}
+	if q > limit {
+		return zero, ErrOverflow
+	}
+	return T(q), nil
+}
+
+// absU64 returns the magnitude of x as a uint64 and whether x is negative.
+func absU64[T Integer](x T) (uint64, bool) {
+	if x < 0 {
+		// Sign-extend then negate in two's complement; also correct for math.MinInt64.
+		return -uint64(x), true
+	}
+	return uint64(x), false
+}
+
 // MustAdd returns a + b, panicking on overflow or underflow.
 func MustAdd[T Integer](a, b T) T {
 	result, err := Add(a, b)
@@ -256,11 +404,45 @@ func Min[T cmp.Ordered](a, b T) T {
 	return b
 }
 
+// Avg returns the midpoint of a and b, rounded toward negative infinity, without overflow.
+// It uses (a&b) + ((a^b)>>1): the shared bits plus half the differing bits. Go's right shift is
+// arithmetic for signed types, so this floors correctly for both signed and unsigned integers,
+// unlike a + (b-a)/2, whose b-a overflows for operands of opposite extreme sign.
+func Avg[T Integer](a, b T) T {
+	return (a & b) + ((a ^ b) >> 1)
+}
+
+// Median returns the middle value of xs and true, or the zero value and false if xs is empty.
+// For an even number of values it returns the lower of the two middle values, so the result
+// is always an element of xs. The input is copied before sorting and is not modified.
+func Median[T cmp.Ordered](xs []T) (T, bool) {
+	if len(xs) == 0 {
+		var zero T
+		return zero, false
+	}
+	sorted := slices.Clone(xs)
+	slices.Sort(sorted)
+	return sorted[(len(sorted)-1)/2], true
+}
+
 // InRange reports whether value is in the inclusive range [min, max].
 func InRange[T cmp.Ordered](value, min, max T) bool {
 	return value >= min && value <= max
 }
 
+// Sign returns -1 if x is negative, 0 if x is zero, and +1 if x is positive.
+// For unsigned types it only ever returns 0 or 1.
+func Sign[T Integer](x T) int {
+	switch {
+	case x < 0:
+		return -1
+	case x > 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
 // Abs returns the absolute value of x.
 // For signed integers, it returns an error if x is the minimum representable value
 // (e.g., math.MinInt64), as its negation would overflow.
@@ -284,6 +466,160 @@ func MustAbs[T Signed](x T) T {
 	return result
 }
 
+// Neg returns -x.
+// It returns ErrOverflow if x is the minimum representable value
+// (e.g., math.MinInt64), as its negation would overflow.
+func Neg[T Signed](x T) (T, error) {
+	if x == minValue[T]() {
+		var zero T
+		return zero, ErrOverflow
+	}
+	return -x, nil
+}
+
+// MustNeg returns -x, panicking on overflow.
+func MustNeg[T Signed](x T) T {
+	result, err := Neg(x)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// TryNeg returns -x and true if no overflow occurs; otherwise false.
+func TryNeg[T Signed](x T) (T, bool) {
+	result, err := Neg(x)
+	return result, err == nil
+}
+
+// AbsDiff returns |a - b|.
+// For unsigned integers the result is always representable. For signed integers
+// it returns ErrOverflow if the distance between a and b exceeds the maximum value of T
+// (e.g., AbsDiff(math.MinInt64, 1)).
+func AbsDiff[T Integer](a, b T) (T, error) {
+	if a < b {
+		a, b = b, a
+	}
+	result := a - b
+
+	// a ≥ b, so a wrapped (negative) result means the span overflowed.
+	// This is never true for unsigned T, which needs no separate check.
+	if result < 0 {
+		var zero T
+		return zero, ErrOverflow
+	}
+
+	return result, nil
+}
+
+// RoundUpToMultiple returns the smallest multiple of multiple that is greater than or equal to x.
+// It returns ErrDivisionByZero if multiple is zero and ErrOverflow if the result
+// exceeds the maximum value of T (e.g., rounding math.MaxInt64 up to a multiple of 8).
+func RoundUpToMultiple[T Integer](x, multiple T) (T, error) {
+	var zero T
+	if multiple == 0 {
+		return zero, ErrDivisionByZero
+	}
+
+	r := x % multiple
+	if r == 0 {
+		return x, nil
+	}
+	if r < 0 {
+		// Truncated remainder of a negative x: dropping it already rounds up.
+		return x - r, nil
+	}
+	// x - r rounds toward zero (down); step one |multiple| further up.
+	if multiple > 0 {
+		return Add(x-r, multiple)
+	}
+	return Sub(x-r, multiple)
+}
+
+// RoundDownToMultiple returns the largest multiple of multiple that is less than or equal to x.
+// It returns ErrDivisionByZero if multiple is zero and ErrUnderflow if the result
+// is below the minimum value of T.
+func RoundDownToMultiple[T Integer](x, multiple T) (T, error) {
+	var zero T
+	if multiple == 0 {
+		return zero, ErrDivisionByZero
+	}
+
+	r := x % multiple
+	if r >= 0 {
+		return x - r, nil
+	}
+	// x - r rounds toward zero (up) for negative x; step one |multiple| further down.
+	if multiple > 0 {
+		return Sub(x-r, multiple)
+	}
+	return Add(x-r, multiple)
+}
+
+// GCD returns the greatest common divisor of a and b using the Euclidean algorithm.
+// For signed integers it operates on absolute values, so the result is non-negative.
+// GCD(0, 0) is 0. The only unrepresentable case is a result of |math.MinInt|
+// (e.g., GCD(math.MinInt64, 0)), for which the minimum value of T is returned unchanged.
+func GCD[T Integer](a, b T) T {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	if a < 0 {
+		// Negation wraps only for the minimum value, which is then returned as is.
+		a = -a
+	}
+	return a
+}
+
+// LCM returns the least common multiple of a and b, which is always non-negative.
+// The LCM of any value with zero is zero. It computes a/GCD(a, b)*b to keep the
+// intermediate small and returns ErrOverflow if the result exceeds the range of T.
+func LCM[T Integer](a, b T) (T, error) {
+	var zero T
+	if a == 0 || b == 0 {
+		return zero, nil
+	}
+
+	g := GCD(a, b)
+	if g < 0 {
+		// GCD is |math.MinInt|: both operands are math.MinInt or 0, so the LCM is too large.
+		return zero, ErrOverflow
+	}
+
+	result, err := Mul(a/g, b)
+	if err != nil {
+		// Underflow here means a negative product whose magnitude is out of range.
+		return zero, ErrOverflow
+	}
+	if result < 0 {
+		if result == minValue[T]() {
+			return zero, ErrOverflow
+		}
+		result = -result
+	}
+	return result, nil
+}
+
+// IsPowerOfTwo reports whether x is a positive power of two.
+func IsPowerOfTwo[T Integer](x T) bool {
+	return x > 0 && x&(x-1) == 0
+}
+
+// NextPowerOfTwo returns the smallest power of two greater than or equal to x.
+// NextPowerOfTwo(0) is 1, and powers of two are returned unchanged.
+// It returns ErrOverflow if that power of two exceeds the maximum value of T.
+func NextPowerOfTwo[T Unsigned](x T) (T, error) {
+	if x <= 1 {
+		return 1, nil
+	}
+	result := T(1) << bits.Len64(uint64(x-1))
+	if result == 0 {
+		// The bit was shifted out of T's width.
+		return 0, ErrOverflow
+	}
+	return result, nil
+}
+
 // Cast safely converts value from type From to type To.
 // It returns an error if the conversion would lose precision or,
 // when converting signed to unsigned, if the value is negative.
@@ -322,52 +658,50 @@ func TryCast[To Integer, From Integer](value From) (To, bool) {
 	return result, err == nil
 }
 
+// ClampCast converts value from type From to type To, saturating instead of failing:
+// values above the maximum of To yield that maximum, and values below the minimum of To
+// (any negative value when To is unsigned) yield that minimum.
+func ClampCast[To Integer, From Integer](value From) To {
+	// Compare in 64-bit space, which is wide enough for every integer type.
+	if value < 0 {
+		if minimum := MinValue[To](); int64(value) < int64(minimum) {
+			return minimum
+		}
+		return To(value)
+	}
+	if maximum := MaxValue[To](); uint64(value) > uint64(maximum) {
+		return maximum
+	}
+	return To(value)
+}
+
 // maxValue returns the maximum value representable by type T.
 func maxValue[T Integer]() T {
-	var v T
-	switch any(v).(type) {
-	case int:
-		return any(math.MaxInt).(T)
-	case int8:
-		return any(int8(math.MaxInt8)).(T)
-	case int16:
-		return any(int16(math.MaxInt16)).(T)
-	case int32:
-		return any(int32(math.MaxInt32)).(T)
-	case int64:
-		return any(int64(math.MaxInt64)).(T)
-	case uint:
-		return any(uint(math.MaxUint)).(T)
-	case uint8:
-		return any(uint8(math.MaxUint8)).(T)
-	case uint16:
-		return any(uint16(math.MaxUint16)).(T)
-	case uint32:
-		return any(uint32(math.MaxUint32)).(T)
-	case uint64:
-		return any(uint64(math.MaxUint64)).(T)
-	case uintptr:
-		return any(^uintptr(0)).(T)
-	}
-	return v
+	return MaxValue[T]()
 }
 
 // minValue returns the minimum value representable by type T.
 // For unsigned types, it returns zero.
 func minValue[T Integer]() T {
-	var v T
-	switch any(v).(type) {
-	case int:
-		return any(math.MinInt).(T)
-	case int8:
-		return any(int8(math.MinInt8)).(T)
-	case int16:
-		return any(int16(math.MinInt16)).(T)
-	case int32:
-		return any(int32(math.MinInt32)).(T)
-	case int64:
-		return any(int64(math.MinInt64)).(T)
-	default:
+	return MinValue[T]()
+}
+
+// MaxValue returns the maximum value representable by type T.
+// The limit is derived from T's size and signedness, so named types such as
+// "type Count int32" get the limit of their underlying type.
+func MaxValue[T Integer]() T {
+	if allOnes := ^T(0); allOnes > 0 {
+		return allOnes
+	}
+	return ^MinValue[T]()
+}
+
+// MinValue returns the minimum value representable by type T.
+// For unsigned types, it returns zero.
+func MinValue[T Integer]() T {
+	var zero T
+	if ^zero > 0 {
 		return 0
 	}
+	return T(1) << (unsafe.Sizeof(zero)*8 - 1)
 }
@@ -3,7 +3,9 @@
 package safemath
 
 import (
+	"errors"
 	"math"
+	"math/bits"
 	"testing"
 )
 
@@ -303,6 +305,107 @@ func TestMod(t *testing.T) {
 	}
 }
 
+// TestDivMod tests the DivMod function
+func TestDivMod(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       int64
+		b       int64
+		wantQ   int64
+		wantR   int64
+		wantErr error
+	}{
+		{name: "positive", a: 17, b: 5, wantQ: 3, wantR: 2},
+		{name: "negative dividend", a: -17, b: 5, wantQ: -3, wantR: -2},
+		{name: "negative divisor", a: 17, b: -5, wantQ: -3, wantR: 2},
+		{name: "both negative", a: -17, b: -5, wantQ: 3, wantR: -2},
+		{name: "exact", a: 20, b: 5, wantQ: 4, wantR: 0},
+		{name: "min int64 by one", a: math.MinInt64, b: 1, wantQ: math.MinInt64, wantR: 0},
+		{name: "division by zero", a: 10, b: 0, wantErr: ErrDivisionByZero},
+		{name: "min int64 by minus one", a: math.MinInt64, b: -1, wantErr: ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, r, err := DivMod(tt.a, tt.b)
+			if err != tt.wantErr {
+				t.Errorf("DivMod() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && (q != tt.wantQ || r != tt.wantR) {
+				t.Errorf("DivMod() = %v, %v, want %v, %v", q, r, tt.wantQ, tt.wantR)
+			}
+		})
+	}
+
+	if q, r, err := DivMod(uint8(255), uint8(7)); err != nil || q != 36 || r != 3 {
+		t.Errorf("DivMod(uint8) = %v, %v, %v, want 36, 3, nil", q, r, err)
+	}
+}
+
+// TestDivModNamedTypes tests the MinInt / -1 overflow check with a named signed type
+func TestDivModNamedTypes(t *testing.T) {
+	type Temp int64
+	type Count uint16
+
+	if _, _, err := DivMod(Temp(math.MinInt64), -1); err != ErrOverflow {
+		t.Errorf("DivMod(Temp(MinInt64), -1) error = %v, want %v", err, ErrOverflow)
+	}
+	if q, r, err := DivMod(Temp(-7), 2); err != nil || q != -3 || r != -1 {
+		t.Errorf("DivMod(Temp(-7), 2) = %v, %v, %v, want -3, -1, nil", q, r, err)
+	}
+	if q, r, err := DivMod(Count(math.MaxUint16), math.MaxUint16); err != nil || q != 1 || r != 0 {
+		t.Errorf("DivMod(Count(MaxUint16), MaxUint16) = %v, %v, %v, want 1, 0, nil", q, r, err)
+	}
+}
+
+// TestFloorCeilDiv compares FloorDiv and CeilDiv against a floating-point reference
+func TestFloorCeilDiv(t *testing.T) {
+	tests := []struct {
+		a int64
+		b int64
+	}{
+		{10, 3}, {-10, 3}, {10, -3}, {-10, -3},
+		{-7, 2}, {7, 2}, {9, 3}, {-9, 3}, {0, 5}, {0, -5}, {1, 1}, {-1, 1},
+	}
+
+	for _, tt := range tests {
+		wantFloor := int64(math.Floor(float64(tt.a) / float64(tt.b)))
+		wantCeil := int64(math.Ceil(float64(tt.a) / float64(tt.b)))
+
+		if got, err := FloorDiv(tt.a, tt.b); err != nil || got != wantFloor {
+			t.Errorf("FloorDiv(%d, %d) = %v, %v, want %v, nil", tt.a, tt.b, got, err, wantFloor)
+		}
+		if got, err := CeilDiv(tt.a, tt.b); err != nil || got != wantCeil {
+			t.Errorf("CeilDiv(%d, %d) = %v, %v, want %v, nil", tt.a, tt.b, got, err, wantCeil)
+		}
+	}
+
+	t.Run("errors", func(t *testing.T) {
+		if _, err := FloorDiv(int64(1), 0); err != ErrDivisionByZero {
+			t.Errorf("FloorDiv() error = %v, want %v", err, ErrDivisionByZero)
+		}
+		if _, err := CeilDiv(uint(1), 0); err != ErrDivisionByZero {
+			t.Errorf("CeilDiv() error = %v, want %v", err, ErrDivisionByZero)
+		}
+		if _, err := FloorDiv(int64(math.MinInt64), -1); err != ErrOverflow {
+			t.Errorf("FloorDiv() error = %v, want %v", err, ErrOverflow)
+		}
+		if _, err := CeilDiv(int64(math.MinInt64), -1); err != ErrOverflow {
+			t.Errorf("CeilDiv() error = %v, want %v", err, ErrOverflow)
+		}
+	})
+
+	t.Run("extremes", func(t *testing.T) {
+		if got, err := FloorDiv(int64(math.MinInt64), 2); err != nil || got != math.MinInt64/2 {
+			t.Errorf("FloorDiv() = %v, %v, want %v, nil", got, err, int64(math.MinInt64/2))
+		}
+		if got, err := CeilDiv(uint64(math.MaxUint64), 2); err != nil || got != 1<<63 {
+			t.Errorf("CeilDiv() = %v, %v, want %v, nil", got, err, uint64(1<<63))
+		}
+	})
+}
+
 // TestMulU64 tests the MulU64 function
 func TestMulU64(t *testing.T) {
 	tests := []struct {
@@ -394,6 +497,149 @@ func TestSubU64(t *testing.T) {
 	}
 }
 
+// TestSum tests the Sum function
+func TestSum(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []int64
+		want    int64
+		wantErr error
+		wantMsg string
+	}{
+		{name: "empty", values: nil, want: 0},
+		{name: "mixed", values: []int64{10, -3, 5}, want: 12},
+		{name: "recovers after large step", values: []int64{math.MaxInt64, -1, 1}, want: math.MaxInt64},
+		{name: "overflow", values: []int64{1, math.MaxInt64, -5}, wantErr: ErrOverflow, wantMsg: "safemath: sum at index 1: safemath: operation would overflow"},
+		{name: "underflow", values: []int64{-1, -2, math.MinInt64}, wantErr: ErrUnderflow, wantMsg: "safemath: sum at index 2: safemath: operation would underflow"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Sum(tt.values)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Sum() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if err.Error() != tt.wantMsg {
+					t.Errorf("Sum() error message = %q, want %q", err.Error(), tt.wantMsg)
+				}
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Sum() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestAddCarry tests AddCarry against bits.Add64
+func TestAddCarry(t *testing.T) {
+	values := []uint64{0, 1, 2, math.MaxUint64 - 1, math.MaxUint64}
+	for _, a := range values {
+		for _, b := range values {
+			for _, c := range []uint64{0, 1} {
+				wantSum, wantCarry := bits.Add64(a, b, c)
+				sum, carry := AddCarry(a, b, c)
+				if sum != wantSum || carry != wantCarry {
+					t.Errorf("AddCarry(%d, %d, %d) = %d, %d, want %d, %d", a, b, c, sum, carry, wantSum, wantCarry)
+				}
+			}
+		}
+	}
+
+	t.Run("uint32 limbs", func(t *testing.T) {
+		// 0x00000001_FFFFFFFF + 0x00000000_00000001 = 0x00000002_00000000
+		lo, carry := AddCarry(uint32(math.MaxUint32), uint32(1), 0)
+		hi, carry := AddCarry(uint32(1), uint32(0), carry)
+		if lo != 0 || hi != 2 || carry != 0 {
+			t.Errorf("AddCarry(uint32) = %#x_%08x carry %d, want 0x2_00000000 carry 0", hi, lo, carry)
+		}
+	})
+}
+
+// TestSubBorrow tests SubBorrow against bits.Sub64
+func TestSubBorrow(t *testing.T) {
+	values := []uint64{0, 1, 2, math.MaxUint64 - 1, math.MaxUint64}
+	for _, a := range values {
+		for _, b := range values {
+			for _, c := range []uint64{0, 1} {
+				wantDiff, wantBorrow := bits.Sub64(a, b, c)
+				diff, borrow := SubBorrow(a, b, c)
+				if diff != wantDiff || borrow != wantBorrow {
+					t.Errorf("SubBorrow(%d, %d, %d) = %d, %d, want %d, %d", a, b, c, diff, borrow, wantDiff, wantBorrow)
+				}
+			}
+		}
+	}
+
+	t.Run("uint8", func(t *testing.T) {
+		diff, borrow := SubBorrow(uint8(0), uint8(255), 1)
+		if diff != 0 || borrow != 1 {
+			t.Errorf("SubBorrow(uint8) = %d, %d, want 0, 1", diff, borrow)
+		}
+	})
+}
+
+// TestMulDiv tests the MulDiv function
+func TestMulDiv(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       int64
+		b       int64
+		c       int64
+		want    int64
+		wantErr error
+	}{
+		{name: "simple", a: 10, b: 3, c: 4, want: 7},
+		{name: "intermediate overflow", a: math.MaxInt64, b: 3, c: 4, want: 6917529027641081855},
+		{name: "negative intermediate overflow", a: math.MinInt64, b: 3, c: 4, want: -6917529027641081856},
+		{name: "negative truncates toward zero", a: -10, b: 3, c: 4, want: -7},
+		{name: "two negatives", a: -10, b: -3, c: 4, want: 7},
+		{name: "negative divisor", a: 10, b: 3, c: -4, want: -7},
+		{name: "exact min", a: math.MinInt64, b: 1, c: 1, want: math.MinInt64},
+		{name: "min via negation", a: math.MinInt64, b: -1, c: -1, want: math.MinInt64},
+		{name: "zero operand", a: 0, b: math.MaxInt64, c: 3, want: 0},
+		{name: "division by zero", a: 1, b: 1, c: 0, wantErr: ErrDivisionByZero},
+		{name: "result overflow", a: math.MaxInt64, b: 2, c: 1, wantErr: ErrOverflow},
+		{name: "min by minus one", a: math.MinInt64, b: -1, c: 1, wantErr: ErrOverflow},
+		{name: "result underflow", a: math.MinInt64, b: 2, c: 1, wantErr: ErrUnderflow},
+		{name: "quotient beyond 64 bits", a: math.MaxInt64, b: math.MaxInt64, c: 1, wantErr: ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MulDiv(tt.a, tt.b, tt.c)
+			if err != tt.wantErr {
+				t.Errorf("MulDiv() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("MulDiv() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("uint64", func(t *testing.T) {
+		got, err := MulDiv(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64))
+		if err != nil || got != math.MaxUint64 {
+			t.Errorf("MulDiv(uint64) = %v, %v, want %v, nil", got, err, uint64(math.MaxUint64))
+		}
+	})
+
+	t.Run("int8", func(t *testing.T) {
+		if got, err := MulDiv(int8(100), int8(100), int8(100)); err != nil || got != 100 {
+			t.Errorf("MulDiv(int8) = %v, %v, want 100, nil", got, err)
+		}
+		if got, err := MulDiv(int8(-128), int8(100), int8(100)); err != nil || got != -128 {
+			t.Errorf("MulDiv(int8) = %v, %v, want -128, nil", got, err)
+		}
+		if _, err := MulDiv(int8(100), int8(100), int8(50)); err != ErrOverflow {
+			t.Errorf("MulDiv(int8) error = %v, want %v", err, ErrOverflow)
+		}
+	})
+}
+
 // TestMustAdd tests the MustAdd function
 func TestMustAdd(t *testing.T) {
 	t.Run("successful addition", func(t *testing.T) {
@@ -655,6 +901,71 @@ func TestMin(t *testing.T) {
 	}
 }
 
+// TestMedian tests the Median function
+func TestMedian(t *testing.T) {
+	tests := []struct {
+		name   string
+		xs     []int
+		want   int
+		wantOk bool
+	}{
+		{name: "empty", xs: nil, want: 0, wantOk: false},
+		{name: "single", xs: []int{7}, want: 7, wantOk: true},
+		{name: "odd count", xs: []int{5, 1, 3}, want: 3, wantOk: true},
+		{name: "even count takes lower middle", xs: []int{4, 1, 3, 2}, want: 2, wantOk: true},
+		{name: "duplicates", xs: []int{2, 2, 9, 2}, want: 2, wantOk: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Median(tt.xs)
+			if ok != tt.wantOk || got != tt.want {
+				t.Errorf("Median() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+
+	t.Run("input not mutated", func(t *testing.T) {
+		xs := []float64{3, 1, 2}
+		Median(xs)
+		if xs[0] != 3 || xs[1] != 1 || xs[2] != 2 {
+			t.Errorf("Median() mutated its input: %v", xs)
+		}
+	})
+}
+
+// TestAvg tests the Avg function
+func TestAvg(t *testing.T) {
+	tests := []struct {
+		name string
+		a    int64
+		b    int64
+		want int64
+	}{
+		{name: "even sum", a: 2, b: 8, want: 5},
+		{name: "odd sum rounds down", a: 2, b: 7, want: 4},
+		{name: "negative odd sum rounds down", a: -2, b: -7, want: -5},
+		{name: "mixed signs", a: -3, b: 4, want: 0},
+		{name: "order independent", a: 7, b: 2, want: 4},
+		{name: "near max", a: math.MaxInt64, b: math.MaxInt64 - 1, want: math.MaxInt64 - 1},
+		{name: "both max", a: math.MaxInt64, b: math.MaxInt64, want: math.MaxInt64},
+		{name: "near min", a: math.MinInt64, b: math.MinInt64 + 1, want: math.MinInt64},
+		{name: "opposite extremes", a: math.MinInt64, b: math.MaxInt64, want: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Avg(tt.a, tt.b); got != tt.want {
+				t.Errorf("Avg() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := Avg(uint64(math.MaxUint64), uint64(math.MaxUint64-1)); got != math.MaxUint64-1 {
+		t.Errorf("Avg(uint64) = %v, want %v", got, uint64(math.MaxUint64-1))
+	}
+}
+
 // TestInRange tests the InRange function
 func TestInRange(t *testing.T) {
 	tests := []struct {
@@ -682,6 +993,36 @@ func TestInRange(t *testing.T) {
 	}
 }
 
+// TestSign tests the Sign function
+func TestSign(t *testing.T) {
+	tests := []struct {
+		name string
+		x    int64
+		want int
+	}{
+		{name: "zero", x: 0, want: 0},
+		{name: "positive", x: 42, want: 1},
+		{name: "negative", x: -42, want: -1},
+		{name: "max int64", x: math.MaxInt64, want: 1},
+		{name: "min int64", x: math.MinInt64, want: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Sign(tt.x); got != tt.want {
+				t.Errorf("Sign() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := Sign(uint64(math.MaxUint64)); got != 1 {
+		t.Errorf("Sign(uint64) = %v, want 1", got)
+	}
+	if got := Sign(uint8(0)); got != 0 {
+		t.Errorf("Sign(uint8) = %v, want 0", got)
+	}
+}
+
 // TestAbs tests the Abs function
 func TestAbs(t *testing.T) {
 	tests := []struct {
@@ -731,6 +1072,319 @@ func TestMustAbs(t *testing.T) {
 	})
 }
 
+// TestNeg tests the Neg function
+func TestNeg(t *testing.T) {
+	tests := []struct {
+		name    string
+		x       int64
+		want    int64
+		wantErr error
+	}{
+		{name: "positive", x: 42, want: -42, wantErr: nil},
+		{name: "negative", x: -42, want: 42, wantErr: nil},
+		{name: "zero", x: 0, want: 0, wantErr: nil},
+		{name: "max int64", x: math.MaxInt64, want: -math.MaxInt64, wantErr: nil},
+		{name: "min int64 plus one", x: math.MinInt64 + 1, want: math.MaxInt64, wantErr: nil},
+		{name: "min int64 overflow", x: math.MinInt64, want: 0, wantErr: ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Neg(tt.x)
+			if err != tt.wantErr {
+				t.Errorf("Neg() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("Neg() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestMustNeg tests the MustNeg function
+func TestMustNeg(t *testing.T) {
+	t.Run("successful neg", func(t *testing.T) {
+		got := MustNeg(int32(7))
+		if got != -7 {
+			t.Errorf("MustNeg() = %v, want %v", got, -7)
+		}
+	})
+
+	t.Run("panic on overflow", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("MustNeg() did not panic")
+			}
+		}()
+		MustNeg(int8(math.MinInt8))
+	})
+}
+
+// TestTryNeg tests the TryNeg function
+func TestTryNeg(t *testing.T) {
+	if got, ok := TryNeg(int16(5)); !ok || got != -5 {
+		t.Errorf("TryNeg() = %v, %v, want -5, true", got, ok)
+	}
+	if _, ok := TryNeg(int16(math.MinInt16)); ok {
+		t.Errorf("TryNeg() ok = true, want false")
+	}
+}
+
+// TestAbsDiff tests the AbsDiff function
+func TestAbsDiff(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       int64
+		b       int64
+		want    int64
+		wantErr error
+	}{
+		{name: "a greater", a: 10, b: 3, want: 7, wantErr: nil},
+		{name: "b greater", a: 3, b: 10, want: 7, wantErr: nil},
+		{name: "equal", a: -5, b: -5, want: 0, wantErr: nil},
+		{name: "mixed signs", a: -10, b: 10, want: 20, wantErr: nil},
+		{name: "max span", a: math.MaxInt64, b: 0, want: math.MaxInt64, wantErr: nil},
+		{name: "min and minus one", a: math.MinInt64, b: -1, want: math.MaxInt64, wantErr: nil},
+		{name: "overflow", a: math.MinInt64, b: 0, want: 0, wantErr: ErrOverflow},
+		{name: "full range overflow", a: math.MinInt64, b: math.MaxInt64, want: 0, wantErr: ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AbsDiff(tt.a, tt.b)
+			if err != tt.wantErr {
+				t.Errorf("AbsDiff() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("AbsDiff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestAbsDiffUnsigned tests AbsDiff with unsigned integers
+func TestAbsDiffUnsigned(t *testing.T) {
+	tests := []struct {
+		name string
+		a    uint64
+		b    uint64
+		want uint64
+	}{
+		{name: "a greater", a: 10, b: 3, want: 7},
+		{name: "b greater", a: 3, b: 10, want: 7},
+		{name: "full range", a: 0, b: math.MaxUint64, want: math.MaxUint64},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AbsDiff(tt.a, tt.b)
+			if err != nil || got != tt.want {
+				t.Errorf("AbsDiff() = %v, %v, want %v, nil", got, err, tt.want)
+			}
+		})
+	}
+}
+
+// TestAbsDiffNamedTypes tests AbsDiff with named integer types
+func TestAbsDiffNamedTypes(t *testing.T) {
+	type Temp int64
+	type Count uint8
+
+	if _, err := AbsDiff(Temp(math.MinInt64), 0); err != ErrOverflow {
+		t.Errorf("AbsDiff(Temp(MinInt64), 0) error = %v, want %v", err, ErrOverflow)
+	}
+	if got, err := AbsDiff(Temp(-3), 4); err != nil || got != 7 {
+		t.Errorf("AbsDiff(Temp(-3), 4) = %v, %v, want 7, nil", got, err)
+	}
+	if got, err := AbsDiff(Count(3), 250); err != nil || got != 247 {
+		t.Errorf("AbsDiff(Count(3), 250) = %v, %v, want 247, nil", got, err)
+	}
+}
+
+// TestRoundToMultiple tests RoundUpToMultiple and RoundDownToMultiple
+func TestRoundToMultiple(t *testing.T) {
+	tests := []struct {
+		name        string
+		x           int64
+		multiple    int64
+		wantUp      int64
+		wantUpErr   error
+		wantDown    int64
+		wantDownErr error
+	}{
+		{name: "already aligned", x: 16, multiple: 8, wantUp: 16, wantDown: 16},
+		{name: "positive", x: 13, multiple: 8, wantUp: 16, wantDown: 8},
+		{name: "negative", x: -13, multiple: 8, wantUp: -8, wantDown: -16},
+		{name: "negative multiple", x: 13, multiple: -8, wantUp: 16, wantDown: 8},
+		{name: "zero", x: 0, multiple: 5, wantUp: 0, wantDown: 0},
+		{name: "zero multiple", x: 10, multiple: 0, wantUpErr: ErrDivisionByZero, wantDownErr: ErrDivisionByZero},
+		{name: "near max", x: math.MaxInt64, multiple: 8, wantUpErr: ErrOverflow, wantDown: math.MaxInt64 - 7},
+		{name: "near min", x: math.MinInt64 + 1, multiple: 8, wantUp: math.MinInt64 + 8, wantDown: math.MinInt64},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			up, err := RoundUpToMultiple(tt.x, tt.multiple)
+			if err != tt.wantUpErr {
+				t.Errorf("RoundUpToMultiple() error = %v, wantErr %v", err, tt.wantUpErr)
+			} else if err == nil && up != tt.wantUp {
+				t.Errorf("RoundUpToMultiple() = %v, want %v", up, tt.wantUp)
+			}
+
+			down, err := RoundDownToMultiple(tt.x, tt.multiple)
+			if err != tt.wantDownErr {
+				t.Errorf("RoundDownToMultiple() error = %v, wantErr %v", err, tt.wantDownErr)
+			} else if err == nil && down != tt.wantDown {
+				t.Errorf("RoundDownToMultiple() = %v, want %v", down, tt.wantDown)
+			}
+		})
+	}
+
+	t.Run("unsigned overflow", func(t *testing.T) {
+		if _, err := RoundUpToMultiple(uint8(250), uint8(16)); err != ErrOverflow {
+			t.Errorf("RoundUpToMultiple(uint8) error = %v, want %v", err, ErrOverflow)
+		}
+		if got, err := RoundDownToMultiple(uint8(250), uint8(16)); err != nil || got != 240 {
+			t.Errorf("RoundDownToMultiple(uint8) = %v, %v, want 240, nil", got, err)
+		}
+	})
+
+	t.Run("signed underflow", func(t *testing.T) {
+		if _, err := RoundDownToMultiple(int8(-127), int8(10)); err != ErrUnderflow {
+			t.Errorf("RoundDownToMultiple(int8) error = %v, want %v", err, ErrUnderflow)
+		}
+	})
+}
+
+// TestGCD tests the GCD function
+func TestGCD(t *testing.T) {
+	tests := []struct {
+		name string
+		a    int64
+		b    int64
+		want int64
+	}{
+		{name: "common divisor", a: 12, b: 18, want: 6},
+		{name: "coprime", a: 17, b: 5, want: 1},
+		{name: "negative operands", a: -12, b: 18, want: 6},
+		{name: "both negative", a: -12, b: -18, want: 6},
+		{name: "zero operand", a: 0, b: 7, want: 7},
+		{name: "both zero", a: 0, b: 0, want: 0},
+		{name: "min int64 with even", a: math.MinInt64, b: 6, want: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GCD(tt.a, tt.b); got != tt.want {
+				t.Errorf("GCD() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := GCD(uint32(math.MaxUint32), uint32(5)); got != 5 {
+		t.Errorf("GCD(uint32) = %v, want 5", got)
+	}
+}
+
+// TestLCM tests the LCM function
+func TestLCM(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       int32
+		b       int32
+		want    int32
+		wantErr error
+	}{
+		{name: "common divisor", a: 4, b: 6, want: 12},
+		{name: "negative operand", a: -4, b: 6, want: 12},
+		{name: "with zero", a: 0, b: 9, want: 0},
+		{name: "coprime near max overflows", a: math.MaxInt32, b: math.MaxInt32 - 1, wantErr: ErrOverflow},
+		{name: "coprime negative near min overflows", a: math.MinInt32 + 1, b: math.MaxInt32 - 1, wantErr: ErrOverflow},
+		{name: "shared factor near max", a: math.MaxInt32 - 1, b: 2, want: math.MaxInt32 - 1},
+		{name: "min int32 overflows", a: math.MinInt32, b: math.MinInt32, wantErr: ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LCM(tt.a, tt.b)
+			if err != tt.wantErr {
+				t.Errorf("LCM() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("LCM() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got, err := LCM(int64(math.MaxInt32), int64(math.MaxInt32-1)); err != nil || got != int64(math.MaxInt32)*(math.MaxInt32-1) {
+		t.Errorf("LCM(int64) = %v, %v, want %v, nil", got, err, int64(math.MaxInt32)*(math.MaxInt32-1))
+	}
+}
+
+// TestIsPowerOfTwo tests the IsPowerOfTwo function
+func TestIsPowerOfTwo(t *testing.T) {
+	tests := []struct {
+		x    int64
+		want bool
+	}{
+		{0, false}, {1, true}, {2, true}, {3, false}, {64, true}, {96, false},
+		{-8, false}, {math.MinInt64, false}, {1 << 62, true}, {math.MaxInt64, false},
+	}
+
+	for _, tt := range tests {
+		if got := IsPowerOfTwo(tt.x); got != tt.want {
+			t.Errorf("IsPowerOfTwo(%d) = %v, want %v", tt.x, got, tt.want)
+		}
+	}
+
+	if !IsPowerOfTwo(uint64(1 << 63)) {
+		t.Errorf("IsPowerOfTwo(1<<63) = false, want true")
+	}
+}
+
+// TestNextPowerOfTwo tests the NextPowerOfTwo function
+func TestNextPowerOfTwo(t *testing.T) {
+	tests := []struct {
+		name    string
+		x       uint64
+		want    uint64
+		wantErr error
+	}{
+		{name: "zero", x: 0, want: 1},
+		{name: "one", x: 1, want: 1},
+		{name: "already power", x: 64, want: 64},
+		{name: "round up", x: 65, want: 128},
+		{name: "largest power", x: 1 << 63, want: 1 << 63},
+		{name: "overflow", x: 1<<63 + 1, wantErr: ErrOverflow},
+		{name: "max overflow", x: math.MaxUint64, wantErr: ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NextPowerOfTwo(tt.x)
+			if err != tt.wantErr {
+				t.Errorf("NextPowerOfTwo() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("NextPowerOfTwo() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("uint8", func(t *testing.T) {
+		if got, err := NextPowerOfTwo(uint8(100)); err != nil || got != 128 {
+			t.Errorf("NextPowerOfTwo(uint8) = %v, %v, want 128, nil", got, err)
+		}
+		if _, err := NextPowerOfTwo(uint8(129)); err != ErrOverflow {
+			t.Errorf("NextPowerOfTwo(uint8) error = %v, want %v", err, ErrOverflow)
+		}
+	})
+}
+
 // TestCast tests the Cast function
 func TestCast(t *testing.T) {
 	tests := []struct {
@@ -867,6 +1521,125 @@ func TestTryCast(t *testing.T) {
 	}
 }
 
+// TestClampCast tests the ClampCast function
+func TestClampCast(t *testing.T) {
+	t.Run("int64 to int32", func(t *testing.T) {
+		tests := []struct {
+			name string
+			from int64
+			want int32
+		}{
+			{name: "within range", from: -100, want: -100},
+			{name: "above max", from: math.MaxInt64, want: math.MaxInt32},
+			{name: "below min", from: math.MinInt64, want: math.MinInt32},
+			{name: "exact max", from: math.MaxInt32, want: math.MaxInt32},
+		}
+		for _, tt := range tests {
+			if got := ClampCast[int32](tt.from); got != tt.want {
+				t.Errorf("%s: ClampCast() = %v, want %v", tt.name, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("signed to unsigned", func(t *testing.T) {
+		if got := ClampCast[uint8](int64(-5)); got != 0 {
+			t.Errorf("ClampCast() = %v, want 0", got)
+		}
+		if got := ClampCast[uint8](int64(300)); got != math.MaxUint8 {
+			t.Errorf("ClampCast() = %v, want %v", got, math.MaxUint8)
+		}
+		if got := ClampCast[uint64](int8(-1)); got != 0 {
+			t.Errorf("ClampCast() = %v, want 0", got)
+		}
+	})
+
+	t.Run("unsigned to signed", func(t *testing.T) {
+		if got := ClampCast[int64](uint64(math.MaxUint64)); got != math.MaxInt64 {
+			t.Errorf("ClampCast() = %v, want %v", got, int64(math.MaxInt64))
+		}
+		if got := ClampCast[int8](uint16(100)); got != 100 {
+			t.Errorf("ClampCast() = %v, want 100", got)
+		}
+	})
+
+	t.Run("widening", func(t *testing.T) {
+		if got := ClampCast[int64](int8(math.MinInt8)); got != math.MinInt8 {
+			t.Errorf("ClampCast() = %v, want %v", got, math.MinInt8)
+		}
+	})
+
+	t.Run("named types", func(t *testing.T) {
+		type Count int32
+		type Level uint8
+
+		if got := ClampCast[Count](int64(5)); got != 5 {
+			t.Errorf("ClampCast() = %v, want 5", got)
+		}
+		if got := ClampCast[Count](int64(math.MaxInt64)); got != math.MaxInt32 {
+			t.Errorf("ClampCast() = %v, want %v", got, math.MaxInt32)
+		}
+		if got := ClampCast[Count](int64(math.MinInt64)); got != math.MinInt32 {
+			t.Errorf("ClampCast() = %v, want %v", got, math.MinInt32)
+		}
+		if got := ClampCast[Level](Count(300)); got != math.MaxUint8 {
+			t.Errorf("ClampCast() = %v, want %v", got, math.MaxUint8)
+		}
+		if got := ClampCast[Level](Count(-1)); got != 0 {
+			t.Errorf("ClampCast() = %v, want 0", got)
+		}
+	})
+}
+
+// TestMaxMinValue tests the MaxValue and MinValue functions
+func TestMaxMinValue(t *testing.T) {
+	if got := MaxValue[int8](); got != math.MaxInt8 {
+		t.Errorf("MaxValue[int8]() = %v, want %v", got, math.MaxInt8)
+	}
+	if got := MinValue[int8](); got != math.MinInt8 {
+		t.Errorf("MinValue[int8]() = %v, want %v", got, math.MinInt8)
+	}
+	if got := MaxValue[int32](); got != math.MaxInt32 {
+		t.Errorf("MaxValue[int32]() = %v, want %v", got, math.MaxInt32)
+	}
+	if got := MinValue[int64](); got != math.MinInt64 {
+		t.Errorf("MinValue[int64]() = %v, want %v", got, int64(math.MinInt64))
+	}
+	if got := MaxValue[uint64](); got != math.MaxUint64 {
+		t.Errorf("MaxValue[uint64]() = %v, want %v", got, uint64(math.MaxUint64))
+	}
+	if got := MinValue[uint16](); got != 0 {
+		t.Errorf("MinValue[uint16]() = %v, want 0", got)
+	}
+	if got := MaxValue[uintptr](); got != ^uintptr(0) {
+		t.Errorf("MaxValue[uintptr]() = %v, want %v", got, ^uintptr(0))
+	}
+}
+
+// TestMaxMinValueNamedTypes verifies that named integer types get the limits of their underlying type
+func TestMaxMinValueNamedTypes(t *testing.T) {
+	type Count int32
+	type Flags uint16
+
+	if got := MaxValue[Count](); got != math.MaxInt32 {
+		t.Errorf("MaxValue[Count]() = %v, want %v", got, math.MaxInt32)
+	}
+	if got := MinValue[Count](); got != math.MinInt32 {
+		t.Errorf("MinValue[Count]() = %v, want %v", got, math.MinInt32)
+	}
+	if got := MaxValue[Flags](); got != math.MaxUint16 {
+		t.Errorf("MaxValue[Flags]() = %v, want %v", got, math.MaxUint16)
+	}
+	if got := MinValue[Flags](); got != 0 {
+		t.Errorf("MinValue[Flags]() = %v, want 0", got)
+	}
+	if got := MaxValue[int](); got != math.MaxInt {
+		t.Errorf("MaxValue[int]() = %v, want %v", got, math.MaxInt)
+	}
+	if got := MinValue[int](); got != math.MinInt {
+		t.Errorf("MinValue[int]() = %v, want %v", got, math.MinInt)
+	}
+}
+
 // TestSmallIntegerTypes tests operations with int8, uint8, etc.
 func TestSmallIntegerTypes(t *testing.T) {
 	t.Run("int8 overflow", func(t *testing.T) {
@@ -0,0 +1,40 @@
+package slices
+
+// ReadOnlySlice is a read-only view of a Slice
+// It exposes no mutators, so it can be handed to untrusted code safely
+// The view reflects later changes made through the originating Slice
+type ReadOnlySlice[T any] struct {
+	s *Slice[T]
+}
+
+// ReadOnly returns a read-only view of the slice
+func (s *Slice[T]) ReadOnly() *ReadOnlySlice[T] {
+	return &ReadOnlySlice[T]{s: s}
+}
+
+// Len returns the length of the slice
+func (r *ReadOnlySlice[T]) Len() int {
+	return r.s.Len()
+}
+
+// Get retrieves an element at the specified index
+// Returns the element and true if index is valid, zero value and false otherwise
+func (r *ReadOnlySlice[T]) Get(index int) (T, bool) {
+	return r.s.Get(index)
+}
+
+// Find finds the first element matching the predicate
+// Returns the element and true if found, zero value and false otherwise
+func (r *ReadOnlySlice[T]) Find(predicate func(T) bool) (T, bool) {
+	return r.s.Find(predicate)
+}
+
+// ForEach applies a function to each element with its index
+func (r *ReadOnlySlice[T]) ForEach(fn func(value T, index int)) {
+	r.s.ForEach(fn)
+}
+
+// ToArray returns a copy of the elements as a standard Go slice
+func (r *ReadOnlySlice[T]) ToArray() []T {
+	return r.s.ToArray()
+}
@@ -0,0 +1,40 @@
+package slices
+
+import "testing"
+
+// TestReadOnly verifies the view exposes data without allowing mutation
+func TestReadOnly(t *testing.T) {
+	s := NewSlice([]int{1, 2, 3})
+	ro := s.ReadOnly()
+
+	if ro.Len() != 3 {
+		t.Errorf("Expected length 3, got %d", ro.Len())
+	}
+
+	if v, ok := ro.Get(1); !ok || v != 2 {
+		t.Errorf("Expected 2 and true, got %d and %v", v, ok)
+	}
+
+	if v, ok := ro.Find(func(x int) bool { return x > 2 }); !ok || v != 3 {
+		t.Errorf("Expected 3 and true, got %d and %v", v, ok)
+	}
+
+	sum := 0
+	ro.ForEach(func(v int, _ int) { sum += v })
+	if sum != 6 {
+		t.Errorf("Expected sum 6, got %d", sum)
+	}
+
+	// Modifying the returned array must not affect the view
+	arr := ro.ToArray()
+	arr[0] = 100
+	if v, _ := ro.Get(0); v != 1 {
+		t.Errorf("Expected 1 after modifying copy, got %d", v)
+	}
+
+	// The view reflects changes made through the owning Slice
+	s.Append(4)
+	if ro.Len() != 4 {
+		t.Errorf("Expected length 4 after append to source, got %d", ro.Len())
+	}
+}
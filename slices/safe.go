@@ -0,0 +1,72 @@
+package slices
+
+import "sync"
+
+// SafeSlice is a Slice guarded by a sync.RWMutex for use across goroutines
+// Read methods take the read lock and mutators take the write lock
+// Methods that iterate or return elements work on a snapshot copied under the lock,
+// so callbacks may call back into the SafeSlice without deadlocking
+type SafeSlice[T any] struct {
+	mu sync.RWMutex
+	s  *Slice[T]
+}
+
+// NewSafeSlice creates a new SafeSlice from an existing slice
+// Creates a copy to prevent external modifications from affecting internal data
+func NewSafeSlice[T any](data []T) *SafeSlice[T] {
+	return &SafeSlice[T]{s: NewSlice(data)}
+}
+
+// Append adds elements to the end of the slice
+func (s *SafeSlice[T]) Append(values ...T) *SafeSlice[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.s.Append(values...)
+	return s
+}
+
+// Len returns the length of the slice
+func (s *SafeSlice[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.Len()
+}
+
+// Get retrieves an element at the specified index
+// Returns the element and true if index is valid, zero value and false otherwise
+func (s *SafeSlice[T]) Get(index int) (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.Get(index)
+}
+
+// Set updates an element at the specified index
+// Returns true if successful, false if index is out of bounds
+func (s *SafeSlice[T]) Set(index int, value T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.s.Set(index, value)
+}
+
+// Pop removes and returns the last element
+// Returns zero value and false if slice is empty
+func (s *SafeSlice[T]) Pop() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.s.Pop()
+}
+
+// ForEach applies a function to each element with its index
+// Iterates over a snapshot taken under the read lock; concurrent changes are not observed
+func (s *SafeSlice[T]) ForEach(fn func(value T, index int)) {
+	for i, v := range s.ToArray() {
+		fn(v, i)
+	}
+}
+
+// ToArray returns a snapshot of the elements as a standard Go slice
+func (s *SafeSlice[T]) ToArray() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.ToArray()
+}
@@ -0,0 +1,58 @@
+package slices
+
+import (
+	"sync"
+	"testing"
+)
+
+// TestSafeSliceConcurrentAppend verifies appends from many goroutines are not lost
+func TestSafeSliceConcurrentAppend(t *testing.T) {
+	s := NewSafeSlice([]int{})
+	const workers, perWorker = 8, 100
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				s.Append(i)
+				s.Len()
+				s.Get(0)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if s.Len() != workers*perWorker {
+		t.Errorf("Expected length %d, got %d", workers*perWorker, s.Len())
+	}
+}
+
+// TestSafeSliceOperations verifies the guarded operations and snapshot iteration
+func TestSafeSliceOperations(t *testing.T) {
+	s := NewSafeSlice([]int{1, 2, 3})
+
+	if !s.Set(0, 10) {
+		t.Errorf("Expected Set to succeed")
+	}
+	if v, ok := s.Get(0); !ok || v != 10 {
+		t.Errorf("Expected 10 and true, got %d and %v", v, ok)
+	}
+	if v, ok := s.Pop(); !ok || v != 3 {
+		t.Errorf("Expected 3 and true, got %d and %v", v, ok)
+	}
+
+	// Callbacks may mutate the SafeSlice because ForEach iterates a snapshot
+	visited := 0
+	s.ForEach(func(v int, _ int) {
+		visited++
+		s.Append(v)
+	})
+	if visited != 2 {
+		t.Errorf("Expected 2 visits over the snapshot, got %d", visited)
+	}
+	if s.Len() != 4 {
+		t.Errorf("Expected length 4 after appends, got %d", s.Len())
+	}
+}
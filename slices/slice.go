@@ -1,13 +1,23 @@
 package slices
 
 import (
+	"fmt"
 	"math/rand"
 	"sort"
+	"strings"
 )
 
+// Number is a constraint for integer and floating-point element types
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
 // Slice represents a generic slice container with built-in operations
 type Slice[T any] struct {
 	data []T
+	rng  *rand.Rand // Optional source for Shuffle; nil uses the global source
 }
 
 // NewSlice creates a new Slice instance from an existing slice
@@ -15,14 +25,14 @@ type Slice[T any] struct {
 func NewSlice[T any](data []T) *Slice[T] {
 	copied := make([]T, len(data))
 	copy(copied, data)
-	return &Slice[T]{copied}
+	return &Slice[T]{data: copied}
 }
 
 // From creates a Slice from variadic arguments
 func From[T any](items ...T) *Slice[T] {
 	copied := make([]T, len(items))
 	copy(copied, items)
-	return &Slice[T]{copied}
+	return &Slice[T]{data: copied}
 }
 
 // Data returns a copy of the underlying slice to prevent external modification
@@ -94,7 +104,7 @@ func (s *Slice[T]) Append(values ...T) *Slice[T] {
 func (s *Slice[T]) Copy() *Slice[T] {
 	newData := make([]T, len(s.data))
 	copy(newData, s.data)
-	return &Slice[T]{newData}
+	return &Slice[T]{data: newData}
 }
 
 // Cut removes elements from index i to j (exclusive: [i, j))
@@ -229,6 +239,19 @@ func (s *Slice[T]) FilterWithoutAllocating(f func(T) bool) *Slice[T] {
 	return s
 }
 
+// Compact removes elements for which isZero returns true, preserving order
+// Useful for stripping empty strings, nil pointers or zero values; the tail is zeroed to help GC
+func (s *Slice[T]) Compact(isZero func(T) bool) *Slice[T] {
+	return s.Filter(func(x T) bool { return !isZero(x) })
+}
+
+// CompactZero removes every element equal to the zero value of T, preserving order
+// Provided as a package function because it requires a comparable element type
+func CompactZero[T comparable](s *Slice[T]) *Slice[T] {
+	var zero T
+	return s.Filter(func(x T) bool { return x != zero })
+}
+
 // Insert inserts an element at index i
 func (s *Slice[T]) Insert(i int, x T) *Slice[T] {
 	if i < 0 || i > len(s.data) {
@@ -251,6 +274,40 @@ func (s *Slice[T]) InsertNoAlloc(i int, x T) *Slice[T] {
 	return s
 }
 
+// InsertAll inserts values at index i, shifting the existing tail only once
+// Out-of-range indices are a no-op, matching Insert
+// values must not alias the slice's own storage; use InsertSlice to insert a slice into itself
+func (s *Slice[T]) InsertAll(i int, values ...T) *Slice[T] {
+	if i < 0 || i > len(s.data) || len(values) == 0 {
+		return s
+	}
+	n := len(s.data)
+	if n+len(values) <= cap(s.data) {
+		s.data = s.data[:n+len(values)]
+		copy(s.data[i+len(values):], s.data[i:n])
+	} else {
+		grown := make([]T, n+len(values), n+len(values)+n/4)
+		copy(grown, s.data[:i])
+		copy(grown[i+len(values):], s.data[i:])
+		s.data = grown
+	}
+	copy(s.data[i:], values)
+	return s
+}
+
+// InsertSlice inserts all elements of other at index i
+// Out-of-range indices and nil other are a no-op; other may be the receiver itself
+func (s *Slice[T]) InsertSlice(i int, other *Slice[T]) *Slice[T] {
+	if other == nil {
+		return s
+	}
+	if other == s {
+		// The shift would overwrite the values being inserted
+		return s.InsertAll(i, s.Copy().data...)
+	}
+	return s.InsertAll(i, other.data...)
+}
+
 // Push adds an element to the end of the slice
 func (s *Slice[T]) Push(x T) *Slice[T] {
 	s.data = append(s.data, x)
@@ -299,11 +356,78 @@ func (s *Slice[T]) Reverse() *Slice[T] {
 	return s
 }
 
+// RotateLeft cyclically shifts elements n positions to the left in place
+// n larger than the length wraps around, and a negative n rotates to the right
+func (s *Slice[T]) RotateLeft(n int) *Slice[T] {
+	length := len(s.data)
+	if length < 2 {
+		return s
+	}
+	n %= length
+	if n < 0 {
+		n += length
+	}
+	if n == 0 {
+		return s
+	}
+	// Reverse both halves, then the whole slice, for O(n) time and O(1) extra space
+	reverseInPlace(s.data[:n])
+	reverseInPlace(s.data[n:])
+	reverseInPlace(s.data)
+	return s
+}
+
+// RotateRight cyclically shifts elements n positions to the right in place
+// n larger than the length wraps around, and a negative n rotates to the left
+func (s *Slice[T]) RotateRight(n int) *Slice[T] {
+	length := len(s.data)
+	if length < 2 {
+		return s
+	}
+	return s.RotateLeft(length - n%length)
+}
+
+// reverseInPlace reverses the elements of data
+func reverseInPlace[T any](data []T) {
+	for left, right := 0, len(data)-1; left < right; left, right = left+1, right-1 {
+		data[left], data[right] = data[right], data[left]
+	}
+}
+
+// Sort sorts the slice in place using the provided less function
+// The sort is not guaranteed to be stable; use SortStable to keep equal elements in order
+func (s *Slice[T]) Sort(less func(a, b T) bool) *Slice[T] {
+	sort.Slice(s.data, func(i, j int) bool {
+		return less(s.data[i], s.data[j])
+	})
+	return s
+}
+
+// SortStable sorts the slice in place, keeping equal elements in their original order
+func (s *Slice[T]) SortStable(less func(a, b T) bool) *Slice[T] {
+	sort.SliceStable(s.data, func(i, j int) bool {
+		return less(s.data[i], s.data[j])
+	})
+	return s
+}
+
+// SetRand sets the random source used by Shuffle, making it reproducible when seeded
+// Passing nil restores the global math/rand source
+// The source is not carried over by Copy or Clone, and *rand.Rand is not safe for concurrent use
+func (s *Slice[T]) SetRand(r *rand.Rand) *Slice[T] {
+	s.rng = r
+	return s
+}
+
 // Shuffle randomizes the order of elements using Fisher-Yates algorithm
-// Note: Uses global rand, consider passing *rand.Rand for better control
+// Uses the source configured by SetRand, falling back to the global math/rand source when none is set
 func (s *Slice[T]) Shuffle() *Slice[T] {
+	intn := rand.Intn
+	if s.rng != nil {
+		intn = s.rng.Intn
+	}
 	for i := len(s.data) - 1; i > 0; i-- {
-		j := rand.Intn(i + 1)
+		j := intn(i + 1)
 		s.data[i], s.data[j] = s.data[j], s.data[i]
 	}
 	return s
@@ -348,6 +472,21 @@ func (s *Slice[T]) Map(fn func(T) T) *Slice[T] {
 	return s
 }
 
+// TryMap transforms each element in place, stopping at the first error returned by fn
+// The slice is partially mutated on failure: elements before the failing index hold their
+// transformed values, while the failing element and everything after it are left unchanged
+// Use MapToNew on a copy first if all-or-nothing behavior is required
+func (s *Slice[T]) TryMap(fn func(T) (T, error)) error {
+	for i, v := range s.data {
+		mapped, err := fn(v)
+		if err != nil {
+			return err
+		}
+		s.data[i] = mapped
+	}
+	return nil
+}
+
 // MapToNew creates a new slice by transforming each element
 // The original slice remains unchanged
 func (s *Slice[T]) MapToNew(fn func(T) T) *Slice[T] {
@@ -358,6 +497,17 @@ func (s *Slice[T]) MapToNew(fn func(T) T) *Slice[T] {
 	return &Slice[T]{data: result}
 }
 
+// MapSlice creates a new Slice by transforming each element into a different type
+// The original slice remains unchanged
+// Provided as a package function because methods cannot declare type parameters
+func MapSlice[T, U any](s *Slice[T], fn func(T) U) *Slice[U] {
+	result := make([]U, len(s.data))
+	for i, v := range s.data {
+		result[i] = fn(v)
+	}
+	return &Slice[U]{data: result}
+}
+
 // Reduce reduces the slice to a single value using an accumulator function
 func (s *Slice[T]) Reduce(initial T, fn func(accumulator T, current T) T) T {
 	result := initial
@@ -401,6 +551,40 @@ func (s *Slice[T]) IndexOf(value T, equal func(a, b T) bool) int {
 	return -1
 }
 
+// FindLast finds the last element matching the predicate
+// Returns the element and true if found, zero value and false otherwise
+func (s *Slice[T]) FindLast(predicate func(T) bool) (T, bool) {
+	for i := len(s.data) - 1; i >= 0; i-- {
+		if predicate(s.data[i]) {
+			return s.data[i], true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// FindIndexLast finds the index of the last element matching the predicate
+// Returns -1 if not found
+func (s *Slice[T]) FindIndexLast(predicate func(T) bool) int {
+	for i := len(s.data) - 1; i >= 0; i-- {
+		if predicate(s.data[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// LastIndexOf finds the index of the last occurrence of a value using a custom equality function
+// Returns -1 if not found
+func (s *Slice[T]) LastIndexOf(value T, equal func(a, b T) bool) int {
+	for i := len(s.data) - 1; i >= 0; i-- {
+		if equal(s.data[i], value) {
+			return i
+		}
+	}
+	return -1
+}
+
 // Contains checks if the slice contains a specific value using a custom equality function
 func (s *Slice[T]) Contains(value T, equal func(a, b T) bool) bool {
 	return s.IndexOf(value, equal) != -1
@@ -426,6 +610,82 @@ func (s *Slice[T]) Any(predicate func(T) bool) bool {
 	return false
 }
 
+// Count returns the number of elements satisfying the predicate
+func (s *Slice[T]) Count(predicate func(T) bool) int {
+	n := 0
+	for _, v := range s.data {
+		if predicate(v) {
+			n++
+		}
+	}
+	return n
+}
+
+// CountBy counts elements per key returned from key, producing a histogram
+// An empty slice yields an empty, non-nil map
+func CountBy[T any, K comparable](s *Slice[T], key func(T) K) map[K]int {
+	counts := make(map[K]int)
+	for _, v := range s.data {
+		counts[key(v)]++
+	}
+	return counts
+}
+
+// MinBy returns the smallest element according to less
+// Ties return the first occurrence; returns zero value and false if slice is empty
+func (s *Slice[T]) MinBy(less func(a, b T) bool) (T, bool) {
+	if len(s.data) == 0 {
+		var zero T
+		return zero, false
+	}
+	result := s.data[0]
+	for _, v := range s.data[1:] {
+		if less(v, result) {
+			result = v
+		}
+	}
+	return result, true
+}
+
+// MaxBy returns the largest element according to less
+// Ties return the first occurrence; returns zero value and false if slice is empty
+func (s *Slice[T]) MaxBy(less func(a, b T) bool) (T, bool) {
+	if len(s.data) == 0 {
+		var zero T
+		return zero, false
+	}
+	result := s.data[0]
+	for _, v := range s.data[1:] {
+		if less(result, v) {
+			result = v
+		}
+	}
+	return result, true
+}
+
+// SumBy sums the numeric value selected from each element
+// Integer overflow is not checked; see the safemath package for checked arithmetic
+func SumBy[T any, N Number](s *Slice[T], sel func(T) N) N {
+	var sum N
+	for _, v := range s.data {
+		sum += sel(v)
+	}
+	return sum
+}
+
+// AverageBy returns the arithmetic mean of the numeric value selected from each element
+// Values are accumulated as float64; returns 0 if slice is empty
+func AverageBy[T any, N Number](s *Slice[T], sel func(T) N) float64 {
+	if len(s.data) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, v := range s.data {
+		sum += float64(sel(v))
+	}
+	return sum / float64(len(s.data))
+}
+
 // Deduplicate removes duplicates using a custom comparator
 // The slice is sorted as a side effect
 // comparator should return: negative if a < b, zero if a == b, positive if a > b
@@ -491,6 +751,35 @@ func (s *Slice[T]) DeduplicateStable(equal func(a, b T) bool) *Slice[T] {
 	return s
 }
 
+// Distinct removes duplicates in place while preserving first-occurrence order
+// It is the O(n) counterpart of DeduplicateStable for comparable element types
+// Provided as a package function because methods cannot add type constraints
+func Distinct[T comparable](s *Slice[T]) *Slice[T] {
+	if len(s.data) <= 1 {
+		return s
+	}
+
+	seen := make(map[T]struct{}, len(s.data))
+	j := 0
+	for _, v := range s.data {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		s.data[j] = v
+		j++
+	}
+
+	// Zero out unused elements
+	for k := j; k < len(s.data); k++ {
+		var zero T
+		s.data[k] = zero
+	}
+
+	s.data = s.data[:j]
+	return s
+}
+
 // Batch divides the slice into batches of specified size
 // Returns a slice of Slice pointers, each containing a batch
 func (s *Slice[T]) Batch(batchSize int) []*Slice[T] {
@@ -512,11 +801,39 @@ func (s *Slice[T]) Batch(batchSize int) []*Slice[T] {
 		// Create a copy of the batch to avoid sharing underlying array
 		batchData := make([]T, end-i)
 		copy(batchData, s.data[i:end])
-		batches = append(batches, &Slice[T]{batchData})
+		batches = append(batches, &Slice[T]{data: batchData})
 	}
 	return batches
 }
 
+// ForEachBatch invokes fn with consecutive batches of up to batchSize elements
+// Iteration stops early if fn returns false
+// The batch is a temporary view over the underlying data and is only valid during the call;
+// use Copy to retain it. A non-positive batchSize yields one batch with all elements
+func (s *Slice[T]) ForEachBatch(batchSize int, fn func(batch *Slice[T]) bool) {
+	if len(s.data) == 0 {
+		return
+	}
+
+	if batchSize <= 0 {
+		batchSize = len(s.data)
+	}
+
+	// Reuse a single view to avoid allocating per batch
+	view := &Slice[T]{}
+	for i := 0; i < len(s.data); i += batchSize {
+		end := i + batchSize
+		if end > len(s.data) {
+			end = len(s.data)
+		}
+		// Limit capacity so appends on the view cannot overwrite following elements
+		view.data = s.data[i:end:end]
+		if !fn(view) {
+			return
+		}
+	}
+}
+
 // SlidingWindow creates sliding windows of specified size
 // Returns overlapping windows advancing one element at a time
 func (s *Slice[T]) SlidingWindow(size int) []*Slice[T] {
@@ -533,16 +850,167 @@ func (s *Slice[T]) SlidingWindow(size int) []*Slice[T] {
 		// Create a copy of the window to avoid sharing underlying array
 		windowData := make([]T, size)
 		copy(windowData, s.data[i:i+size])
-		result = append(result, &Slice[T]{windowData})
+		result = append(result, &Slice[T]{data: windowData})
+	}
+	return result
+}
+
+// ChunkOverlap splits the slice into windows of the given size whose starts are step elements apart
+// step == size behaves like Batch and step == 1 behaves like SlidingWindow; step = size/2 gives 50% overlap
+// A trailing partial window is included only when it holds elements no earlier window covered,
+// so the final window may be shorter than size
+// When step > size the elements between windows are skipped, e.g. size 2 and step 4 over 1..9 gives {1,2},{5,6},{9}
+// Returns an empty result if size or step is not positive
+func (s *Slice[T]) ChunkOverlap(size, step int) []*Slice[T] {
+	if size <= 0 || step <= 0 || len(s.data) == 0 {
+		return []*Slice[T]{}
+	}
+
+	var result []*Slice[T]
+	for i := 0; i < len(s.data); i += step {
+		end := i + size
+		if end > len(s.data) {
+			end = len(s.data)
+		}
+		// Create a copy of the window to avoid sharing underlying array
+		windowData := make([]T, end-i)
+		copy(windowData, s.data[i:end])
+		result = append(result, &Slice[T]{data: windowData})
+		if end == len(s.data) {
+			break
+		}
 	}
 	return result
 }
 
+// ForEachWindow invokes fn with each sliding window of the specified size without copying
+// Windows follow the same rules as SlidingWindow, including a single window when size exceeds the length
+// The window is a view into the underlying data: treat it as read-only and do not retain it after fn returns
+func (s *Slice[T]) ForEachWindow(size int, fn func(window []T)) {
+	if size <= 0 || len(s.data) == 0 {
+		return
+	}
+
+	if size > len(s.data) {
+		size = len(s.data)
+	}
+
+	for i := 0; i <= len(s.data)-size; i++ {
+		// Limit capacity so appends on the window cannot overwrite following elements
+		fn(s.data[i : i+size : i+size])
+	}
+}
+
 // Chunk is an alias for Batch for convenience
 func (s *Slice[T]) Chunk(chunkSize int) []*Slice[T] {
 	return s.Batch(chunkSize)
 }
 
+// GroupBy buckets elements by the key returned from key
+// Each group preserves the original relative order of its elements
+// An empty slice yields an empty, non-nil map
+func GroupBy[T any, K comparable](s *Slice[T], key func(T) K) map[K]*Slice[T] {
+	groups := make(map[K]*Slice[T])
+	for _, v := range s.data {
+		k := key(v)
+		group, ok := groups[k]
+		if !ok {
+			group = &Slice[T]{data: []T{}}
+			groups[k] = group
+		}
+		group.data = append(group.data, v)
+	}
+	return groups
+}
+
+// ToMap builds a map from the key/value pairs returned by fn for each element
+// On duplicate keys the last element wins; use GroupBy to keep every element per key
+// An empty slice yields an empty, non-nil map
+func ToMap[T any, K comparable, V any](s *Slice[T], fn func(T) (K, V)) map[K]V {
+	result := make(map[K]V, len(s.data))
+	for _, v := range s.data {
+		k, val := fn(v)
+		result[k] = val
+	}
+	return result
+}
+
+// Intersect returns the elements of a whose key also appears in b, in the order of a
+// Results have set semantics: each key appears at most once, keeping its first occurrence in a
+// Nil operands are treated as empty; runs in O(len(a)+len(b))
+func Intersect[T any, K comparable](a, b *Slice[T], key func(T) K) *Slice[T] {
+	inB := keySet(b, key)
+	seen := make(map[K]struct{})
+	result := []T{}
+	for _, v := range sliceData(a) {
+		k := key(v)
+		if _, ok := inB[k]; !ok {
+			continue
+		}
+		if _, dup := seen[k]; dup {
+			continue
+		}
+		seen[k] = struct{}{}
+		result = append(result, v)
+	}
+	return &Slice[T]{data: result}
+}
+
+// Union returns the elements of a followed by the elements of b whose key is not in a
+// Results have set semantics: each key appears at most once, keeping its first occurrence
+// Nil operands are treated as empty; runs in O(len(a)+len(b))
+func Union[T any, K comparable](a, b *Slice[T], key func(T) K) *Slice[T] {
+	seen := make(map[K]struct{})
+	result := []T{}
+	for _, data := range [][]T{sliceData(a), sliceData(b)} {
+		for _, v := range data {
+			k := key(v)
+			if _, dup := seen[k]; dup {
+				continue
+			}
+			seen[k] = struct{}{}
+			result = append(result, v)
+		}
+	}
+	return &Slice[T]{data: result}
+}
+
+// Difference returns the elements of a whose key does not appear in b, in the order of a
+// Results have set semantics: each key appears at most once, keeping its first occurrence in a
+// Nil operands are treated as empty; runs in O(len(a)+len(b))
+func Difference[T any, K comparable](a, b *Slice[T], key func(T) K) *Slice[T] {
+	seen := keySet(b, key)
+	result := []T{}
+	for _, v := range sliceData(a) {
+		k := key(v)
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		// Marking the key as seen also drops later duplicates from a
+		seen[k] = struct{}{}
+		result = append(result, v)
+	}
+	return &Slice[T]{data: result}
+}
+
+// keySet collects the keys of all elements of s
+func keySet[T any, K comparable](s *Slice[T], key func(T) K) map[K]struct{} {
+	data := sliceData(s)
+	set := make(map[K]struct{}, len(data))
+	for _, v := range data {
+		set[key(v)] = struct{}{}
+	}
+	return set
+}
+
+// sliceData returns the underlying data, treating a nil Slice as empty
+func sliceData[T any](s *Slice[T]) []T {
+	if s == nil {
+		return nil
+	}
+	return s.data
+}
+
 // ToArray returns the underlying slice as a standard Go slice
 // Always returns a copy to maintain encapsulation
 func (s *Slice[T]) ToArray() []T {
@@ -556,6 +1024,26 @@ func (s *Slice[T]) ToSlice() []T {
 	return s.ToArray()
 }
 
+// String implements fmt.Stringer, rendering elements like "[1 2 3]"
+// using the default formatting of the element type
+func (s *Slice[T]) String() string {
+	return fmt.Sprint(s.data)
+}
+
+// StringFunc renders the slice like String but formats each element with fn
+func (s *Slice[T]) StringFunc(fn func(T) string) string {
+	var b strings.Builder
+	b.WriteByte('[')
+	for i, v := range s.data {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(fn(v))
+	}
+	b.WriteByte(']')
+	return b.String()
+}
+
 // Chain allows method chaining by returning the slice itself
 func (s *Slice[T]) Chain() *Slice[T] {
 	return s
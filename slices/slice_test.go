@@ -1,6 +1,8 @@
 package slices
 
 import (
+	"errors"
+	"fmt"
 	"math/rand"
 	"testing"
 )
@@ -43,6 +45,33 @@ func TestFilter(t *testing.T) {
 	}
 }
 
+// TestCompact verifies zero-value removal and tail zeroing
+func TestCompact(t *testing.T) {
+	row := NewSlice([]string{"", "a", "", "b", "c", ""})
+	row.Compact(func(v string) bool { return v == "" })
+	if got := fmt.Sprint(row.ToArray()); got != "[a b c]" {
+		t.Errorf("Expected [a b c], got %s", got)
+	}
+
+	a, b := 1, 2
+	ptrs := NewSlice([]*int{nil, &a, nil, &b})
+	data := ptrs.DataUnsafe()
+	CompactZero(ptrs)
+	if ptrs.Len() != 2 {
+		t.Fatalf("Expected 2 non-nil pointers, got %d", ptrs.Len())
+	}
+	// The tail of the backing array must be cleared so dropped pointers can be collected
+	for i := ptrs.Len(); i < len(data); i++ {
+		if data[i] != nil {
+			t.Errorf("Expected tail index %d to be nil", i)
+		}
+	}
+
+	if got := CompactZero(NewSlice([]int{0, 0})).Len(); got != 0 {
+		t.Errorf("Expected all-zero slice to become empty, got length %d", got)
+	}
+}
+
 // TestPop verifies GC cleanup in Pop
 func TestPop(t *testing.T) {
 	s := NewSlice([]int{1, 2, 3, 4, 5})
@@ -100,6 +129,46 @@ func TestBatch(t *testing.T) {
 	}
 }
 
+// TestForEachBatch verifies batch iteration and early termination
+func TestForEachBatch(t *testing.T) {
+	s := NewSlice([]int{1, 2, 3, 4, 5, 6, 7})
+
+	var sizes []int
+	s.ForEachBatch(3, func(batch *Slice[int]) bool {
+		sizes = append(sizes, batch.Len())
+		return true
+	})
+
+	expected := []int{3, 3, 1}
+	if len(sizes) != len(expected) {
+		t.Fatalf("Expected %d batches, got %d", len(expected), len(sizes))
+	}
+	for i := range expected {
+		if sizes[i] != expected[i] {
+			t.Errorf("Batch %d: expected size %d, got %d", i, expected[i], sizes[i])
+		}
+	}
+
+	// Early termination
+	calls := 0
+	s.ForEachBatch(2, func(batch *Slice[int]) bool {
+		calls++
+		return false
+	})
+	if calls != 1 {
+		t.Errorf("Expected 1 call after early stop, got %d", calls)
+	}
+
+	// Appending to a batch must not clobber the source
+	s.ForEachBatch(3, func(batch *Slice[int]) bool {
+		batch.Append(100)
+		return true
+	})
+	if v, _ := s.Get(3); v != 4 {
+		t.Errorf("Expected source to be unchanged at index 3, got %d", v)
+	}
+}
+
 // TestSlidingWindow verifies edge cases
 func TestSlidingWindow(t *testing.T) {
 	s := NewSlice([]int{1, 2, 3, 4, 5})
@@ -123,6 +192,180 @@ func TestSlidingWindow(t *testing.T) {
 	}
 }
 
+// TestChunkOverlap verifies overlapping windows and the trailing partial window
+func TestChunkOverlap(t *testing.T) {
+	s := NewSlice([]int{1, 2, 3, 4, 5, 6, 7, 8, 9})
+
+	tests := []struct {
+		name       string
+		size, step int
+		expected   [][]int
+	}{
+		{"half overlap", 4, 2, [][]int{{1, 2, 3, 4}, {3, 4, 5, 6}, {5, 6, 7, 8}, {7, 8, 9}}},
+		{"step equals size", 4, 4, [][]int{{1, 2, 3, 4}, {5, 6, 7, 8}, {9}}},
+		{"step of one", 7, 1, [][]int{{1, 2, 3, 4, 5, 6, 7}, {2, 3, 4, 5, 6, 7, 8}, {3, 4, 5, 6, 7, 8, 9}}},
+		{"step larger than size", 2, 4, [][]int{{1, 2}, {5, 6}, {9}}},
+		{"size exceeds length", 20, 3, [][]int{{1, 2, 3, 4, 5, 6, 7, 8, 9}}},
+		{"invalid size", 0, 1, nil},
+		{"invalid step", 3, 0, nil},
+	}
+
+	for _, tt := range tests {
+		windows := s.ChunkOverlap(tt.size, tt.step)
+		if len(windows) != len(tt.expected) {
+			t.Errorf("%s: expected %d windows, got %d", tt.name, len(tt.expected), len(windows))
+			continue
+		}
+		for i, w := range windows {
+			got := w.ToArray()
+			if fmt.Sprint(got) != fmt.Sprint(tt.expected[i]) {
+				t.Errorf("%s: window %d expected %v, got %v", tt.name, i, tt.expected[i], got)
+			}
+		}
+	}
+
+	// Windows must not share the underlying array with the source
+	windows := s.ChunkOverlap(4, 2)
+	windows[0].Set(0, 100)
+	if v, _ := s.Get(0); v != 1 {
+		t.Errorf("Expected source to be unaffected, got %d", v)
+	}
+}
+
+// TestGroupBy verifies bucketing preserves order within groups
+func TestGroupBy(t *testing.T) {
+	s := NewSlice([]int{1, 2, 3, 4, 5, 6, 7})
+	groups := GroupBy(s, func(x int) int { return x % 3 })
+
+	if len(groups) != 3 {
+		t.Fatalf("Expected 3 groups, got %d", len(groups))
+	}
+
+	expected := map[int][]int{0: {3, 6}, 1: {1, 4, 7}, 2: {2, 5}}
+	for k, want := range expected {
+		got := groups[k].ToArray()
+		if len(got) != len(want) {
+			t.Errorf("Group %d: expected %v, got %v", k, want, got)
+			continue
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("Group %d: expected %v, got %v", k, want, got)
+				break
+			}
+		}
+	}
+
+	empty := GroupBy(NewSlice([]int{}), func(x int) int { return x })
+	if empty == nil || len(empty) != 0 {
+		t.Errorf("Expected empty non-nil map, got %v", empty)
+	}
+}
+
+// TestToMap verifies indexing by key with last-write-wins on duplicates
+func TestToMap(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+	s := NewSlice([]user{{1, "a"}, {2, "b"}, {1, "c"}})
+	index := ToMap(s, func(u user) (int, string) { return u.id, u.name })
+
+	if len(index) != 2 {
+		t.Fatalf("Expected 2 keys, got %d", len(index))
+	}
+	if index[1] != "c" {
+		t.Errorf("Expected last write c for key 1, got %s", index[1])
+	}
+	if index[2] != "b" {
+		t.Errorf("Expected b for key 2, got %s", index[2])
+	}
+
+	empty := ToMap(NewSlice([]user{}), func(u user) (int, string) { return u.id, u.name })
+	if empty == nil || len(empty) != 0 {
+		t.Errorf("Expected empty non-nil map, got %v", empty)
+	}
+}
+
+// TestSetOperations verifies Intersect, Union and Difference keep left order and dedupe by key
+func TestSetOperations(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+	key := func(u user) int { return u.id }
+	ids := func(s *Slice[user]) string {
+		return fmt.Sprint(MapSlice(s, key).ToArray())
+	}
+
+	old := NewSlice([]user{{3, "c"}, {1, "a"}, {2, "b"}, {1, "a2"}})
+	current := NewSlice([]user{{2, "b"}, {4, "d"}, {3, "c"}, {4, "d2"}})
+
+	if got := ids(Intersect(old, current, key)); got != "[3 2]" {
+		t.Errorf("Intersect: expected [3 2], got %s", got)
+	}
+	if got := ids(Union(old, current, key)); got != "[3 1 2 4]" {
+		t.Errorf("Union: expected [3 1 2 4], got %s", got)
+	}
+	if got := ids(Difference(old, current, key)); got != "[1]" {
+		t.Errorf("Difference (removed): expected [1], got %s", got)
+	}
+	if got := ids(Difference(current, old, key)); got != "[4]" {
+		t.Errorf("Difference (added): expected [4], got %s", got)
+	}
+
+	// First occurrence wins for duplicate keys
+	if u, _ := Union(old, current, key).Get(1); u.name != "a" {
+		t.Errorf("Expected first occurrence a, got %s", u.name)
+	}
+
+	if got := Intersect(old, nil, key).Len(); got != 0 {
+		t.Errorf("Expected empty intersection with nil, got length %d", got)
+	}
+	if got := ids(Union(nil, current, key)); got != "[2 4 3]" {
+		t.Errorf("Union with nil: expected [2 4 3], got %s", got)
+	}
+}
+
+// TestForEachWindow verifies windows match SlidingWindow without copying
+func TestForEachWindow(t *testing.T) {
+	s := NewSlice([]int{1, 2, 3, 4, 5})
+
+	var sums []int
+	s.ForEachWindow(3, func(window []int) {
+		sum := 0
+		for _, v := range window {
+			sum += v
+		}
+		sums = append(sums, sum)
+	})
+
+	expected := []int{6, 9, 12}
+	if len(sums) != len(expected) {
+		t.Fatalf("Expected %d windows, got %d", len(expected), len(sums))
+	}
+	for i := range expected {
+		if sums[i] != expected[i] {
+			t.Errorf("Window %d: expected sum %d, got %d", i, expected[i], sums[i])
+		}
+	}
+
+	calls := 0
+	s.ForEachWindow(10, func(window []int) {
+		calls++
+		if len(window) != 5 {
+			t.Errorf("Expected window of 5 when size > length, got %d", len(window))
+		}
+	})
+	if calls != 1 {
+		t.Errorf("Expected 1 window when size > length, got %d", calls)
+	}
+
+	s.ForEachWindow(0, func(window []int) {
+		t.Errorf("Expected no windows for size 0")
+	})
+}
+
 // TestMethodChaining demonstrates method chaining
 func TestMethodChaining(t *testing.T) {
 	s := NewSlice([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
@@ -145,6 +388,76 @@ func TestMethodChaining(t *testing.T) {
 	}
 }
 
+// TestSort verifies sorting and chaining
+func TestSort(t *testing.T) {
+	s := NewSlice([]int{9, 4, 7, 2, 8, 1, 6})
+
+	result := s.
+		Filter(func(x int) bool { return x > 1 }).
+		Sort(func(a, b int) bool { return a < b }).
+		Take(3).
+		ToArray()
+
+	expected := []int{2, 4, 6}
+	if len(result) != len(expected) {
+		t.Fatalf("Expected length %d, got %d", len(expected), len(result))
+	}
+	for i := range expected {
+		if result[i] != expected[i] {
+			t.Errorf("At index %d: expected %d, got %d", i, expected[i], result[i])
+		}
+	}
+}
+
+// TestSortStable verifies equal elements keep their order
+func TestSortStable(t *testing.T) {
+	type pair struct{ key, order int }
+	s := NewSlice([]pair{{2, 0}, {1, 1}, {2, 2}, {1, 3}, {2, 4}})
+	s.SortStable(func(a, b pair) bool { return a.key < b.key })
+
+	expected := []int{1, 3, 0, 2, 4}
+	for i, p := range s.ToArray() {
+		if p.order != expected[i] {
+			t.Errorf("At index %d: expected order %d, got %d", i, expected[i], p.order)
+		}
+	}
+}
+
+// TestRotate verifies rotation in both directions, wrap-around and negative counts
+func TestRotate(t *testing.T) {
+	tests := []struct {
+		name     string
+		rotate   func(s *Slice[int]) *Slice[int]
+		expected []int
+	}{
+		{"left by 2", func(s *Slice[int]) *Slice[int] { return s.RotateLeft(2) }, []int{3, 4, 5, 1, 2}},
+		{"right by 2", func(s *Slice[int]) *Slice[int] { return s.RotateRight(2) }, []int{4, 5, 1, 2, 3}},
+		{"left by length", func(s *Slice[int]) *Slice[int] { return s.RotateLeft(5) }, []int{1, 2, 3, 4, 5}},
+		{"left wraps", func(s *Slice[int]) *Slice[int] { return s.RotateLeft(7) }, []int{3, 4, 5, 1, 2}},
+		{"right wraps", func(s *Slice[int]) *Slice[int] { return s.RotateRight(12) }, []int{4, 5, 1, 2, 3}},
+		{"negative left", func(s *Slice[int]) *Slice[int] { return s.RotateLeft(-1) }, []int{5, 1, 2, 3, 4}},
+		{"negative right", func(s *Slice[int]) *Slice[int] { return s.RotateRight(-6) }, []int{2, 3, 4, 5, 1}},
+	}
+
+	for _, tt := range tests {
+		result := tt.rotate(NewSlice([]int{1, 2, 3, 4, 5})).ToArray()
+		for i := range tt.expected {
+			if result[i] != tt.expected[i] {
+				t.Errorf("%s: expected %v, got %v", tt.name, tt.expected, result)
+				break
+			}
+		}
+	}
+
+	// Empty and single-element slices are unchanged
+	if NewSlice([]int{}).RotateLeft(3).Len() != 0 {
+		t.Errorf("Expected empty slice to stay empty")
+	}
+	if v, _ := NewSlice([]int{9}).RotateRight(4).First(); v != 9 {
+		t.Errorf("Expected single element 9, got %d", v)
+	}
+}
+
 // TestInsertNoAlloc verifies the fix using zero value
 func TestInsertNoAlloc(t *testing.T) {
 	s := NewSlice([]int{1, 2, 4, 5})
@@ -164,6 +477,45 @@ func TestInsertNoAlloc(t *testing.T) {
 	}
 }
 
+// TestInsertAll verifies bulk insertion, self-insertion and out-of-range handling
+func TestInsertAll(t *testing.T) {
+	tests := []struct {
+		name     string
+		index    int
+		values   []int
+		expected string
+	}{
+		{"middle", 2, []int{7, 8, 9}, "[1 2 7 8 9 3 4]"},
+		{"front", 0, []int{7, 8}, "[7 8 1 2 3 4]"},
+		{"end", 4, []int{7}, "[1 2 3 4 7]"},
+		{"negative index", -1, []int{7}, "[1 2 3 4]"},
+		{"index past end", 5, []int{7}, "[1 2 3 4]"},
+		{"no values", 1, nil, "[1 2 3 4]"},
+	}
+
+	for _, tt := range tests {
+		// Run against both a full and a spare-capacity backing array
+		full := NewSlice([]int{1, 2, 3, 4})
+		spare := &Slice[int]{data: append(make([]int, 0, 16), 1, 2, 3, 4)}
+		for _, s := range []*Slice[int]{full, spare} {
+			if got := fmt.Sprint(s.InsertAll(tt.index, tt.values...).ToArray()); got != tt.expected {
+				t.Errorf("%s: expected %s, got %s", tt.name, tt.expected, got)
+			}
+		}
+	}
+
+	s := &Slice[int]{data: append(make([]int, 0, 16), 1, 2, 3)}
+	s.InsertSlice(1, s)
+	if got := fmt.Sprint(s.ToArray()); got != "[1 1 2 3 2 3]" {
+		t.Errorf("Expected [1 1 2 3 2 3], got %s", got)
+	}
+
+	s.InsertSlice(0, nil)
+	if s.Len() != 6 {
+		t.Errorf("Expected length 6 after inserting nil, got %d", s.Len())
+	}
+}
+
 // TestAppendSlice verifies nil safety
 func TestAppendSlice(t *testing.T) {
 	s := NewSlice([]int{1, 2, 3})
@@ -182,6 +534,55 @@ func TestAppendSlice(t *testing.T) {
 	}
 }
 
+// TestTryMap verifies in-place transformation and partial mutation on error
+func TestTryMap(t *testing.T) {
+	s := NewSlice([]int{1, 2, 3})
+	if err := s.TryMap(func(x int) (int, error) { return x * 10, nil }); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if fmt.Sprint(s.ToArray()) != "[10 20 30]" {
+		t.Errorf("Expected [10 20 30], got %v", s.ToArray())
+	}
+
+	errInvalid := errors.New("invalid")
+	s = NewSlice([]int{1, 2, -3, 4})
+	err := s.TryMap(func(x int) (int, error) {
+		if x < 0 {
+			return 0, errInvalid
+		}
+		return x * 10, nil
+	})
+	if err != errInvalid {
+		t.Fatalf("Expected errInvalid, got %v", err)
+	}
+	if fmt.Sprint(s.ToArray()) != "[10 20 -3 4]" {
+		t.Errorf("Expected [10 20 -3 4] after partial mutation, got %v", s.ToArray())
+	}
+}
+
+// TestMapSlice verifies mapping to a different element type
+func TestMapSlice(t *testing.T) {
+	s := NewSlice([]int{1, 2, 3})
+	mapped := MapSlice(s, func(x int) string { return fmt.Sprintf("#%d", x) })
+
+	expected := []string{"#1", "#2", "#3"}
+	result := mapped.ToArray()
+
+	if len(result) != len(expected) {
+		t.Fatalf("Expected length %d, got %d", len(expected), len(result))
+	}
+
+	for i := range expected {
+		if result[i] != expected[i] {
+			t.Errorf("At index %d: expected %s, got %s", i, expected[i], result[i])
+		}
+	}
+
+	if v, _ := s.Get(0); v != 1 {
+		t.Errorf("Expected source to be unchanged, got %d at index 0", v)
+	}
+}
+
 // TestReduce demonstrates usage
 func TestReduce(t *testing.T) {
 	s := NewSlice([]int{1, 2, 3, 4, 5})
@@ -207,6 +608,31 @@ func TestFind(t *testing.T) {
 	}
 }
 
+// TestReverseSearch verifies FindLast, FindIndexLast and LastIndexOf
+func TestReverseSearch(t *testing.T) {
+	s := NewSlice([]int{1, 2, 3, 2, 1})
+
+	if val, ok := s.FindLast(func(x int) bool { return x < 3 }); !ok || val != 1 {
+		t.Errorf("FindLast: expected 1 and true, got %d and %v", val, ok)
+	}
+
+	if idx := s.FindIndexLast(func(x int) bool { return x == 2 }); idx != 3 {
+		t.Errorf("FindIndexLast: expected 3, got %d", idx)
+	}
+
+	if idx := s.FindIndexLast(func(x int) bool { return x > 10 }); idx != -1 {
+		t.Errorf("FindIndexLast: expected -1, got %d", idx)
+	}
+
+	if idx := s.LastIndexOf(1, func(a, b int) bool { return a == b }); idx != 4 {
+		t.Errorf("LastIndexOf: expected 4, got %d", idx)
+	}
+
+	if idx := NewSlice([]int{}).LastIndexOf(1, func(a, b int) bool { return a == b }); idx != -1 {
+		t.Errorf("LastIndexOf on empty slice: expected -1, got %d", idx)
+	}
+}
+
 // TestShuffleWithRand verifies reproducible shuffling
 func TestShuffleWithRand(t *testing.T) {
 	s1 := NewSlice([]int{1, 2, 3, 4, 5})
@@ -229,6 +655,27 @@ func TestShuffleWithRand(t *testing.T) {
 	}
 }
 
+// TestSetRand verifies Shuffle uses the configured source
+func TestSetRand(t *testing.T) {
+	s1 := NewSlice([]int{1, 2, 3, 4, 5, 6, 7, 8}).SetRand(rand.New(rand.NewSource(42)))
+	s2 := NewSlice([]int{1, 2, 3, 4, 5, 6, 7, 8}).SetRand(rand.New(rand.NewSource(42)))
+
+	result1 := s1.Shuffle().ToArray()
+	result2 := s2.Shuffle().ToArray()
+
+	for i := range result1 {
+		if result1[i] != result2[i] {
+			t.Errorf("Shuffles with same seed should match, but differ at index %d", i)
+		}
+	}
+
+	// Falling back to the global source must still produce a permutation
+	s1.SetRand(nil).Shuffle()
+	if s1.Len() != 8 {
+		t.Errorf("Expected length 8 after shuffle, got %d", s1.Len())
+	}
+}
+
 // TestAll and TestAny
 func TestAllAny(t *testing.T) {
 	s := NewSlice([]int{2, 4, 6, 8})
@@ -242,6 +689,75 @@ func TestAllAny(t *testing.T) {
 	}
 }
 
+// TestCount verifies Count and CountBy
+func TestCount(t *testing.T) {
+	s := NewSlice([]int{1, 2, 3, 4, 5, 6, 7})
+
+	if n := s.Count(func(x int) bool { return x%2 == 0 }); n != 3 {
+		t.Errorf("Expected 3 even elements, got %d", n)
+	}
+
+	counts := CountBy(s, func(x int) bool { return x > 4 })
+	if counts[true] != 3 || counts[false] != 4 {
+		t.Errorf("Expected 3 true and 4 false, got %v", counts)
+	}
+
+	if s.Len() != 7 {
+		t.Errorf("Expected source to be unchanged, got length %d", s.Len())
+	}
+}
+
+// TestMinByMaxBy verifies extremal elements and tie-breaking
+func TestMinByMaxBy(t *testing.T) {
+	type offer struct {
+		id    int
+		price int
+	}
+	s := NewSlice([]offer{{1, 30}, {2, 10}, {3, 50}, {4, 10}, {5, 50}})
+	less := func(a, b offer) bool { return a.price < b.price }
+
+	if o, ok := s.MinBy(less); !ok || o.id != 2 {
+		t.Errorf("MinBy: expected offer 2, got %v and %v", o, ok)
+	}
+
+	if o, ok := s.MaxBy(less); !ok || o.id != 3 {
+		t.Errorf("MaxBy: expected offer 3, got %v and %v", o, ok)
+	}
+
+	empty := NewSlice([]offer{})
+	if _, ok := empty.MinBy(less); ok {
+		t.Error("MinBy: expected false for empty slice")
+	}
+	if _, ok := empty.MaxBy(less); ok {
+		t.Error("MaxBy: expected false for empty slice")
+	}
+}
+
+// TestSumByAverageBy verifies numeric aggregation over a field
+func TestSumByAverageBy(t *testing.T) {
+	type item struct {
+		qty   int
+		price float64
+	}
+	s := NewSlice([]item{{2, 1.5}, {3, 2.0}, {5, 0.5}})
+
+	if total := SumBy(s, func(i item) int { return i.qty }); total != 10 {
+		t.Errorf("SumBy: expected 10, got %d", total)
+	}
+
+	if total := SumBy(s, func(i item) float64 { return i.price }); total != 4.0 {
+		t.Errorf("SumBy: expected 4.0, got %v", total)
+	}
+
+	if avg := AverageBy(s, func(i item) int { return i.qty }); avg != 10.0/3 {
+		t.Errorf("AverageBy: expected %v, got %v", 10.0/3, avg)
+	}
+
+	if avg := AverageBy(NewSlice([]item{}), func(i item) int { return i.qty }); avg != 0 {
+		t.Errorf("AverageBy: expected 0 for empty slice, got %v", avg)
+	}
+}
+
 // TestTakeSkip verifies new utility methods
 func TestTakeSkip(t *testing.T) {
 	s := NewSlice([]int{1, 2, 3, 4, 5})
@@ -282,6 +798,43 @@ func TestDeduplicateStable(t *testing.T) {
 	}
 }
 
+// TestDistinct verifies map-based order-preserving deduplication
+func TestDistinct(t *testing.T) {
+	s := NewSlice([]int{3, 1, 2, 1, 3, 2, 4})
+	Distinct(s)
+
+	expected := []int{3, 1, 2, 4}
+	result := s.ToArray()
+
+	if len(result) != len(expected) {
+		t.Fatalf("Expected length %d, got %d", len(expected), len(result))
+	}
+
+	for i := range expected {
+		if result[i] != expected[i] {
+			t.Errorf("At index %d: expected %d, got %d", i, expected[i], result[i])
+		}
+	}
+}
+
+// TestString verifies fmt.Stringer output
+func TestString(t *testing.T) {
+	s := NewSlice([]int{1, 2, 3})
+
+	if got := fmt.Sprint(s); got != "[1 2 3]" {
+		t.Errorf("Expected [1 2 3], got %s", got)
+	}
+
+	if got := NewSlice([]int{}).String(); got != "[]" {
+		t.Errorf("Expected [], got %s", got)
+	}
+
+	got := s.StringFunc(func(x int) string { return fmt.Sprintf("<%d>", x) })
+	if got != "[<1> <2> <3>]" {
+		t.Errorf("Expected [<1> <2> <3>], got %s", got)
+	}
+}
+
 // BenchmarkFilter benchmarks the Filter operation
 func BenchmarkFilter(b *testing.B) {
 	data := make([]int, 1000)
@@ -0,0 +1,24 @@
+package slicex
+
+import "iter"
+
+// ChunkSeq returns an iterator over consecutive chunks of up to size elements of s.
+// Each chunk is a view sharing s's backing array (with capacity limited to its length),
+// so no chunk data is copied. A non-positive size yields s as a single chunk, mirroring
+// slices.Slice.Batch. An empty s yields nothing.
+func ChunkSeq[T any](s []T, size int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if len(s) == 0 {
+			return
+		}
+		if size <= 0 {
+			size = len(s)
+		}
+		for i := 0; i < len(s); i += size {
+			end := min(i+size, len(s))
+			if !yield(s[i:end:end]) {
+				return
+			}
+		}
+	}
+}
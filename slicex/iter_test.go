@@ -0,0 +1,48 @@
+package slicex
+
+import "testing"
+
+// TestChunkSeq verifies lazy chunk iteration
+func TestChunkSeq(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5, 6, 7}
+
+	var sizes []int
+	for chunk := range ChunkSeq(s, 3) {
+		sizes = append(sizes, len(chunk))
+	}
+	expected := []int{3, 3, 1}
+	if len(sizes) != len(expected) {
+		t.Fatalf("Expected %d chunks, got %d", len(expected), len(sizes))
+	}
+	for i := range expected {
+		if sizes[i] != expected[i] {
+			t.Errorf("Chunk %d: expected size %d, got %d", i, expected[i], sizes[i])
+		}
+	}
+
+	// Early break
+	count := 0
+	for range ChunkSeq(s, 2) {
+		count++
+		break
+	}
+	if count != 1 {
+		t.Errorf("Expected 1 chunk before break, got %d", count)
+	}
+
+	// Invalid size yields a single chunk
+	count = 0
+	for chunk := range ChunkSeq(s, 0) {
+		count++
+		if len(chunk) != len(s) {
+			t.Errorf("Expected single chunk of %d, got %d", len(s), len(chunk))
+		}
+	}
+	if count != 1 {
+		t.Errorf("Expected 1 chunk for invalid size, got %d", count)
+	}
+
+	for range ChunkSeq([]int{}, 3) {
+		t.Errorf("Expected no chunks for empty input")
+	}
+}
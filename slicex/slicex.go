@@ -0,0 +1,421 @@
+// Package slicex provides generic helper functions that operate directly on
+// plain Go slices.
+//
+// Unlike the slices package, which wraps data in a Slice container, the
+// functions here accept and return ordinary []T values so they compose with
+// any code that already works with built-in slices.
+package slicex
+
+import (
+	"cmp"
+	"errors"
+	"math/rand"
+)
+
+// ErrIndexOutOfRange is returned when an index is outside the bounds of a slice.
+var ErrIndexOutOfRange = errors.New("slicex: index out of range")
+
+// Number is a constraint for integer and floating-point element types.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Coalesce returns the first value that is not equal to T's zero value.
+// If every value is zero (or no values are given), the zero value is returned.
+func Coalesce[T comparable](values ...T) T {
+	var zero T
+	for _, v := range values {
+		if v != zero {
+			return v
+		}
+	}
+	return zero
+}
+
+// CoalesceFunc returns the first value for which isZero reports false.
+// It is the counterpart of Coalesce for types that are not comparable.
+// If every value is considered zero (or no values are given), the zero value is returned.
+func CoalesceFunc[T any](isZero func(T) bool, values ...T) T {
+	for _, v := range values {
+		if !isZero(v) {
+			return v
+		}
+	}
+	var zero T
+	return zero
+}
+
+// DistinctBy returns a new slice containing the first element for each distinct key,
+// preserving the original order. The input slice is not modified.
+func DistinctBy[T any, K comparable](s []T, key func(T) K) []T {
+	result := make([]T, 0, len(s))
+	seen := make(map[K]struct{}, len(s))
+	for _, v := range s {
+		k := key(v)
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		result = append(result, v)
+	}
+	return result
+}
+
+// Tail returns all elements except the first as a view sharing s's backing array.
+// It returns an empty slice if s has at most one element.
+func Tail[T any](s []T) []T {
+	if len(s) <= 1 {
+		return []T{}
+	}
+	return s[1:]
+}
+
+// Init returns all elements except the last as a view sharing s's backing array.
+// It returns an empty slice if s has at most one element. The result's capacity
+// is limited to its length so appending to it cannot overwrite the last element of s.
+func Init[T any](s []T) []T {
+	if len(s) <= 1 {
+		return []T{}
+	}
+	return s[: len(s)-1 : len(s)-1]
+}
+
+// IndexFunc returns the index of the first element satisfying pred, or -1 if none does.
+// Unlike an equality-based search it works for element types that are not comparable.
+func IndexFunc[T any](s []T, pred func(T) bool) int {
+	for i, v := range s {
+		if pred(v) {
+			return i
+		}
+	}
+	return -1
+}
+
+// ContainsFunc reports whether at least one element satisfies pred.
+// It returns false for an empty slice.
+func ContainsFunc[T any](s []T, pred func(T) bool) bool {
+	return IndexFunc(s, pred) >= 0
+}
+
+// LastIndex returns the index of the last occurrence of x in s, or -1 if x is not present.
+func LastIndex[T comparable](s []T, x T) int {
+	for i := len(s) - 1; i >= 0; i-- {
+		if s[i] == x {
+			return i
+		}
+	}
+	return -1
+}
+
+// LastIndexFunc returns the index of the last element satisfying pred, or -1 if none does.
+// The slice is scanned from the end, so the search stops at the most recent match.
+func LastIndexFunc[T any](s []T, pred func(T) bool) int {
+	for i := len(s) - 1; i >= 0; i-- {
+		if pred(s[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// DeleteFast removes the element at index i by moving the last element into its place.
+// It runs in O(1) but does not preserve order. The vacated tail slot is zeroed so the
+// garbage collector can reclaim anything it referenced. It returns ErrIndexOutOfRange
+// and s unchanged if i is out of bounds.
+func DeleteFast[T any](s []T, i int) ([]T, error) {
+	_, out, err := DeleteFastGet(s, i)
+	return out, err
+}
+
+// DeleteFastGet is like DeleteFast but also returns the removed element,
+// which is useful when the freed item needs to be reset or reused.
+func DeleteFastGet[T any](s []T, i int) (removed T, out []T, err error) {
+	if i < 0 || i >= len(s) {
+		return removed, s, ErrIndexOutOfRange
+	}
+	last := len(s) - 1
+	removed = s[i]
+	s[i] = s[last]
+	var zero T
+	s[last] = zero
+	return removed, s[:last], nil
+}
+
+// FilterInto appends the elements of src for which keep returns true to dst and returns
+// the extended slice. src is never modified. The semantics are append-only: existing
+// elements of dst are kept, so pass buf[:0] to reuse a scratch buffer's capacity
+// without allocating in the steady state.
+func FilterInto[T any](dst, src []T, keep func(T) bool) []T {
+	for _, v := range src {
+		if keep(v) {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// ReduceWhile folds s from left to right, starting with init, until f returns false.
+// The accumulator returned by the call that stopped the fold is the result, so f can
+// decide whether the current element still counts. If f never stops, every element is
+// folded; an empty slice returns init.
+func ReduceWhile[T, U any](s []T, init U, f func(U, T) (U, bool)) U {
+	acc := init
+	for _, v := range s {
+		var cont bool
+		acc, cont = f(acc, v)
+		if !cont {
+			break
+		}
+	}
+	return acc
+}
+
+// MapWindows maps every sliding window of size consecutive elements to a single value,
+// without materializing the windows as a [][]T. The window passed to f is a view into s
+// (capacity limited to its length): treat it as read-only and do not retain it after f
+// returns. It returns nil if size <= 0 or size > len(s).
+func MapWindows[T, U any](s []T, size int, f func(window []T) U) []U {
+	if size <= 0 || size > len(s) {
+		return nil
+	}
+	result := make([]U, 0, len(s)-size+1)
+	for i := 0; i+size <= len(s); i++ {
+		result = append(result, f(s[i:i+size:i+size]))
+	}
+	return result
+}
+
+// Pair holds two values of possibly different types, as produced by Zip2.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Zip2 combines a and b element-wise into pairs. If the slices differ in length,
+// the result is truncated to the shorter one and the extra elements are ignored.
+func Zip2[A, B any](a []A, b []B) []Pair[A, B] {
+	return ZipWith(a, b, func(x A, y B) Pair[A, B] {
+		return Pair[A, B]{First: x, Second: y}
+	})
+}
+
+// ZipWith combines a and b element-wise using f. If the slices differ in length,
+// the result is truncated to the shorter one and the extra elements are ignored.
+func ZipWith[A, B, C any](a []A, b []B, f func(A, B) C) []C {
+	n := min(len(a), len(b))
+	result := make([]C, n)
+	for i := 0; i < n; i++ {
+		result[i] = f(a[i], b[i])
+	}
+	return result
+}
+
+// DedupeStable returns a new slice with all duplicate elements removed, keeping the
+// first occurrence of each value in its original position. Unlike sorting-based
+// deduplication, the relative order of s is preserved. The input slice is not modified.
+func DedupeStable[T comparable](s []T) []T {
+	return DistinctBy(s, func(v T) T { return v })
+}
+
+// Concat returns a new slice containing the elements of all given slices in order.
+// The total length is computed up front so the result is allocated exactly once.
+// Nil and empty inputs are skipped; if there is nothing to copy, an empty slice is returned.
+func Concat[T any](slices ...[]T) []T {
+	total := 0
+	for _, s := range slices {
+		total += len(s)
+	}
+	result := make([]T, 0, total)
+	for _, s := range slices {
+		result = append(result, s...)
+	}
+	return result
+}
+
+// Fill sets every element of s to value. After seeding the first element it copies
+// the already-filled prefix onto the rest, doubling the filled region each time,
+// which lets copy's bulk memory moves do most of the work for large slices.
+func Fill[T any](s []T, value T) {
+	if len(s) == 0 {
+		return
+	}
+	s[0] = value
+	for filled := 1; filled < len(s); filled *= 2 {
+		copy(s[filled:], s[:filled])
+	}
+}
+
+// Repeat returns a new slice of length n with every element set to value.
+// It returns an empty slice if n <= 0.
+func Repeat[T any](value T, n int) []T {
+	if n <= 0 {
+		return []T{}
+	}
+	result := make([]T, n)
+	Fill(result, value)
+	return result
+}
+
+// Flatten concatenates the inner slices of s into a single new slice, allocated once
+// with the combined length. It is the inverse of chunking; nil inner slices are treated
+// as empty. The result never aliases any input.
+func Flatten[T any](s [][]T) []T {
+	return Concat(s...)
+}
+
+// GroupBy splits s into runs of consecutive elements, starting a new group whenever
+// sameGroup(prev, cur) reports false for adjacent elements. Each group is a view sharing
+// s's backing array (with capacity limited to its length), so modifying a group's
+// elements modifies s. An empty s returns nil.
+func GroupBy[T any](s []T, sameGroup func(prev, cur T) bool) [][]T {
+	if len(s) == 0 {
+		return nil
+	}
+	var groups [][]T
+	start := 0
+	for i := 1; i < len(s); i++ {
+		if !sameGroup(s[i-1], s[i]) {
+			groups = append(groups, s[start:i:i])
+			start = i
+		}
+	}
+	return append(groups, s[start:len(s):len(s)])
+}
+
+// BinarySearch searches for target in s, which must be sorted in ascending order.
+// It returns the index where target is found, or the index where it would be inserted
+// to keep s sorted, together with whether it was found. With duplicates, the index of
+// the first match is returned.
+func BinarySearch[T cmp.Ordered](s []T, target T) (int, bool) {
+	return BinarySearchFunc(s, func(v T) int { return cmp.Compare(v, target) })
+}
+
+// BinarySearchFunc is like BinarySearch but uses compare to locate the target.
+// compare must return a negative number if the element sorts before the target,
+// zero if it matches, and a positive number if it sorts after. s must be sorted
+// consistently with compare.
+func BinarySearchFunc[T any](s []T, compare func(T) int) (int, bool) {
+	lo, hi := 0, len(s)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if compare(s[mid]) < 0 {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo, lo < len(s) && compare(s[lo]) == 0
+}
+
+// Equal reports whether a and b have the same length and equal elements in order.
+// A nil slice and an empty non-nil slice are considered equal.
+func Equal[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualFunc is like Equal but compares elements with eq.
+// A nil slice and an empty non-nil slice are considered equal.
+func EqualFunc[T any](a, b []T, eq func(T, T) bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !eq(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// KeyBy indexes the elements of s by the key returned from key.
+// When several elements share a key, the last one wins.
+// An empty s yields an empty, non-nil map.
+func KeyBy[T any, K comparable](s []T, key func(T) K) map[K]T {
+	return ToMap(s, func(v T) (K, T) { return key(v), v })
+}
+
+// ToMap builds a map from the key/value pairs returned by f for each element of s.
+// When several elements produce the same key, the last one wins.
+// An empty s yields an empty, non-nil map.
+func ToMap[T any, K comparable, V any](s []T, f func(T) (K, V)) map[K]V {
+	result := make(map[K]V, len(s))
+	for _, v := range s {
+		k, val := f(v)
+		result[k] = val
+	}
+	return result
+}
+
+// Shuffle randomizes the order of s in place using the Fisher-Yates algorithm and the
+// global math/rand source. Use ShuffleRand for reproducible or per-goroutine shuffles.
+func Shuffle[T any](s []T) {
+	shuffle(s, rand.Intn)
+}
+
+// ShuffleRand is like Shuffle but draws from r, so shuffles are reproducible with a
+// seeded source and avoid contention on the global source. A *rand.Rand is not safe
+// for concurrent use, so give each goroutine its own.
+func ShuffleRand[T any](s []T, r *rand.Rand) {
+	shuffle(s, r.Intn)
+}
+
+// shuffle performs a Fisher-Yates shuffle using intn to pick swap positions.
+func shuffle[T any](s []T, intn func(int) int) {
+	for i := len(s) - 1; i > 0; i-- {
+		j := intn(i + 1)
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+// Sum returns the sum of all elements of s, or zero for an empty slice.
+// Integer sums wrap around silently on overflow; use safemath.Sum when overflow
+// must be detected.
+func Sum[T Number](s []T) T {
+	var total T
+	for _, v := range s {
+		total += v
+	}
+	return total
+}
+
+// Max returns the largest element of s and true, or the zero value and false if s is empty.
+// If several elements are equal to the maximum, the first one is returned.
+func Max[T cmp.Ordered](s []T) (T, bool) {
+	if len(s) == 0 {
+		var zero T
+		return zero, false
+	}
+	best := s[0]
+	for _, v := range s[1:] {
+		if v > best {
+			best = v
+		}
+	}
+	return best, true
+}
+
+// Min returns the smallest element of s and true, or the zero value and false if s is empty.
+// If several elements are equal to the minimum, the first one is returned.
+func Min[T cmp.Ordered](s []T) (T, bool) {
+	if len(s) == 0 {
+		var zero T
+		return zero, false
+	}
+	best := s[0]
+	for _, v := range s[1:] {
+		if v < best {
+			best = v
+		}
+	}
+	return best, true
+}
@@ -0,0 +1,598 @@
+package slicex
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// TestCoalesce verifies the first non-zero value is returned
+func TestCoalesce(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		want   string
+	}{
+		{name: "first non-zero", values: []string{"", "a", "b"}, want: "a"},
+		{name: "first value", values: []string{"x", "", "b"}, want: "x"},
+		{name: "all zero", values: []string{"", ""}, want: ""},
+		{name: "no values", values: nil, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Coalesce(tt.values...); got != tt.want {
+				t.Errorf("Coalesce() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestCoalesceFunc verifies coalescing with a custom zero check
+func TestCoalesceFunc(t *testing.T) {
+	isEmpty := func(s []int) bool { return len(s) == 0 }
+
+	got := CoalesceFunc(isEmpty, nil, []int{}, []int{1, 2}, []int{3})
+	if len(got) != 2 || got[0] != 1 {
+		t.Errorf("CoalesceFunc() = %v, want [1 2]", got)
+	}
+
+	if got := CoalesceFunc(isEmpty, nil, []int{}); got != nil {
+		t.Errorf("CoalesceFunc() = %v, want nil", got)
+	}
+}
+
+// TestDistinctBy verifies the first element per key is kept in order
+func TestDistinctBy(t *testing.T) {
+	type record struct {
+		id   int
+		name string
+	}
+
+	input := []record{{1, "a"}, {2, "b"}, {1, "c"}, {3, "d"}, {2, "e"}}
+	got := DistinctBy(input, func(r record) int { return r.id })
+
+	expected := []record{{1, "a"}, {2, "b"}, {3, "d"}}
+	if len(got) != len(expected) {
+		t.Fatalf("Expected length %d, got %d", len(expected), len(got))
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("At index %d: expected %v, got %v", i, expected[i], got[i])
+		}
+	}
+
+	if input[2].name != "c" {
+		t.Errorf("Expected input to be unchanged, got %v", input)
+	}
+}
+
+// TestTailInit verifies the remainder views
+func TestTailInit(t *testing.T) {
+	s := []int{1, 2, 3}
+
+	if got := Tail(s); len(got) != 2 || got[0] != 2 || got[1] != 3 {
+		t.Errorf("Tail() = %v, want [2 3]", got)
+	}
+	if got := Init(s); len(got) != 2 || got[0] != 1 || got[1] != 2 {
+		t.Errorf("Init() = %v, want [1 2]", got)
+	}
+
+	for _, in := range [][]int{nil, {1}} {
+		if got := Tail(in); got == nil || len(got) != 0 {
+			t.Errorf("Tail(%v) = %#v, want empty slice", in, got)
+		}
+		if got := Init(in); got == nil || len(got) != 0 {
+			t.Errorf("Init(%v) = %#v, want empty slice", in, got)
+		}
+	}
+
+	_ = append(Init(s), 100)
+	if s[2] != 3 {
+		t.Errorf("Appending to Init() overwrote the source: %v", s)
+	}
+}
+
+// TestIndexFuncContainsFunc verifies predicate-based searching
+func TestIndexFuncContainsFunc(t *testing.T) {
+	type point struct{ x, y float64 }
+	points := []point{{0, 0}, {1.0001, 2}, {3, 4}}
+	near := func(p point) bool { return math.Abs(p.x-1) < 0.01 && math.Abs(p.y-2) < 0.01 }
+
+	if got := IndexFunc(points, near); got != 1 {
+		t.Errorf("IndexFunc() = %d, want 1", got)
+	}
+	if !ContainsFunc(points, near) {
+		t.Errorf("ContainsFunc() = false, want true")
+	}
+
+	none := func(p point) bool { return p.x < 0 }
+	if got := IndexFunc(points, none); got != -1 {
+		t.Errorf("IndexFunc() = %d, want -1", got)
+	}
+	if ContainsFunc(points, none) {
+		t.Errorf("ContainsFunc() = true, want false")
+	}
+
+	if got := IndexFunc(nil, near); got != -1 {
+		t.Errorf("IndexFunc(nil) = %d, want -1", got)
+	}
+	if ContainsFunc([]point{}, near) {
+		t.Errorf("ContainsFunc(empty) = true, want false")
+	}
+}
+
+// TestLastIndex verifies searching from the end
+func TestLastIndex(t *testing.T) {
+	tests := []struct {
+		name string
+		s    []int
+		x    int
+		want int
+	}{
+		{name: "last of several", s: []int{1, 2, 1, 3}, x: 1, want: 2},
+		{name: "not found", s: []int{1, 2, 3}, x: 4, want: -1},
+		{name: "empty", s: nil, x: 1, want: -1},
+		{name: "single match", s: []int{5}, x: 5, want: 0},
+		{name: "single miss", s: []int{5}, x: 6, want: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LastIndex(tt.s, tt.x); got != tt.want {
+				t.Errorf("LastIndex() = %d, want %d", got, tt.want)
+			}
+			eq := func(v int) bool { return v == tt.x }
+			if got := LastIndexFunc(tt.s, eq); got != tt.want {
+				t.Errorf("LastIndexFunc() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestDeleteFastGet verifies swap-removal returns the removed element and clears the tail
+func TestDeleteFastGet(t *testing.T) {
+	a, b, c := 1, 2, 3
+	s := []*int{&a, &b, &c}
+
+	removed, out, err := DeleteFastGet(s, 0)
+	if err != nil {
+		t.Fatalf("DeleteFastGet() error = %v", err)
+	}
+	if removed != &a {
+		t.Errorf("DeleteFastGet() removed = %v, want %v", removed, &a)
+	}
+	if len(out) != 2 || out[0] != &c || out[1] != &b {
+		t.Errorf("DeleteFastGet() out = %v, want [%v %v]", out, &c, &b)
+	}
+	if s[2] != nil {
+		t.Errorf("Expected vacated tail slot to be nil, got %v", s[2])
+	}
+
+	out, err = DeleteFast(out, 1)
+	if err != nil || len(out) != 1 || out[0] != &c {
+		t.Errorf("DeleteFast() = %v, %v, want [%v] and nil", out, err, &c)
+	}
+
+	for _, i := range []int{-1, 1} {
+		removed, got, err := DeleteFastGet(out, i)
+		if err != ErrIndexOutOfRange {
+			t.Errorf("DeleteFastGet(%d) error = %v, want ErrIndexOutOfRange", i, err)
+		}
+		if removed != nil || len(got) != 1 {
+			t.Errorf("DeleteFastGet(%d) = %v, %v, want nil and unchanged slice", i, removed, got)
+		}
+	}
+}
+
+// TestFilterInto verifies matches are appended to dst and src is untouched
+func TestFilterInto(t *testing.T) {
+	src := []int{1, 2, 3, 4, 5, 6}
+	even := func(v int) bool { return v%2 == 0 }
+
+	got := FilterInto([]int{0}, src, even)
+	if len(got) != 4 || got[0] != 0 || got[1] != 2 || got[3] != 6 {
+		t.Errorf("FilterInto() = %v, want [0 2 4 6]", got)
+	}
+	if src[1] != 2 || len(src) != 6 {
+		t.Errorf("Expected src to be unchanged, got %v", src)
+	}
+
+	buf := make([]int, 0, len(src))
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = FilterInto(buf[:0], src, even)
+	})
+	if allocs != 0 {
+		t.Errorf("FilterInto() with reused buffer allocated %v times, want 0", allocs)
+	}
+
+	if got := FilterInto(nil, src, func(int) bool { return false }); got != nil {
+		t.Errorf("FilterInto() with no matches = %v, want nil", got)
+	}
+}
+
+// TestReduceWhile verifies folding stops when the callback returns false
+func TestReduceWhile(t *testing.T) {
+	costs := []int{3, 4, 5, 6}
+
+	// Accumulate while the budget of 10 is not exceeded
+	calls := 0
+	budget := func(acc, v int) (int, bool) {
+		calls++
+		if acc+v > 10 {
+			return acc, false
+		}
+		return acc + v, true
+	}
+	if got := ReduceWhile(costs, 0, budget); got != 7 || calls != 3 {
+		t.Errorf("ReduceWhile() = %d after %d calls, want 7 after 3", got, calls)
+	}
+
+	first := func(acc, v int) (int, bool) { return acc + v, false }
+	if got := ReduceWhile(costs, 100, first); got != 103 {
+		t.Errorf("ReduceWhile() stopping at first element = %d, want 103", got)
+	}
+
+	never := func(acc string, v int) (string, bool) { return acc + fmt.Sprint(v), true }
+	if got := ReduceWhile(costs, ">", never); got != ">3456" {
+		t.Errorf("ReduceWhile() never stopping = %q, want %q", got, ">3456")
+	}
+
+	if got := ReduceWhile(nil, 42, first); got != 42 {
+		t.Errorf("ReduceWhile(nil) = %d, want 42", got)
+	}
+}
+
+// TestMapWindows verifies each window is mapped in order
+func TestMapWindows(t *testing.T) {
+	s := []float64{1, 2, 3, 4, 5}
+	avg := func(w []float64) float64 {
+		sum := 0.0
+		for _, v := range w {
+			sum += v
+		}
+		return sum / float64(len(w))
+	}
+
+	got := MapWindows(s, 3, avg)
+	want := []float64{2, 3, 4}
+	if len(got) != len(want) {
+		t.Fatalf("MapWindows() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("MapWindows()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	if got := MapWindows(s, 5, avg); len(got) != 1 || got[0] != 3 {
+		t.Errorf("MapWindows() with size == len = %v, want [3]", got)
+	}
+	for _, size := range []int{0, -1, 6} {
+		if got := MapWindows(s, size, avg); got != nil {
+			t.Errorf("MapWindows(size=%d) = %v, want nil", size, got)
+		}
+	}
+}
+
+// TestZip verifies element-wise combination and truncation to the shorter input
+func TestZip(t *testing.T) {
+	names := []string{"a", "b", "c"}
+	ages := []int{1, 2}
+
+	pairs := Zip2(names, ages)
+	if len(pairs) != 2 {
+		t.Fatalf("Zip2() length = %d, want 2", len(pairs))
+	}
+	if pairs[0] != (Pair[string, int]{"a", 1}) || pairs[1] != (Pair[string, int]{"b", 2}) {
+		t.Errorf("Zip2() = %v, want [{a 1} {b 2}]", pairs)
+	}
+
+	sums := ZipWith([]int{1, 2, 3}, []int{10, 20, 30, 40}, func(x, y int) int { return x + y })
+	if len(sums) != 3 || sums[0] != 11 || sums[2] != 33 {
+		t.Errorf("ZipWith() = %v, want [11 22 33]", sums)
+	}
+
+	if got := Zip2(names, []int(nil)); got == nil || len(got) != 0 {
+		t.Errorf("Zip2() with nil input = %#v, want empty slice", got)
+	}
+}
+
+// TestDedupeStable verifies interleaved duplicates are removed in first-occurrence order
+func TestDedupeStable(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []int
+		want []int
+	}{
+		{name: "interleaved", in: []int{3, 1, 3, 2, 1}, want: []int{3, 1, 2}},
+		{name: "no duplicates", in: []int{1, 2, 3}, want: []int{1, 2, 3}},
+		{name: "all equal", in: []int{7, 7, 7}, want: []int{7}},
+		{name: "empty", in: nil, want: []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DedupeStable(tt.in)
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("DedupeStable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestConcat verifies concatenation with a single allocation
+func TestConcat(t *testing.T) {
+	a, b := []int{1, 2}, []int{3}
+	got := Concat(a, nil, b, []int{}, []int{4, 5})
+	if fmt.Sprint(got) != "[1 2 3 4 5]" {
+		t.Errorf("Concat() = %v, want [1 2 3 4 5]", got)
+	}
+	if cap(got) != 5 {
+		t.Errorf("Concat() capacity = %d, want 5", cap(got))
+	}
+
+	got[0] = 100
+	if a[0] != 1 {
+		t.Errorf("Expected inputs to be unaffected, got %v", a)
+	}
+
+	allocs := testing.AllocsPerRun(100, func() { _ = Concat(a, b, a) })
+	if allocs != 1 {
+		t.Errorf("Concat() allocated %v times, want 1", allocs)
+	}
+
+	for _, in := range [][][]int{nil, {nil, {}}} {
+		if got := Concat(in...); got == nil || len(got) != 0 {
+			t.Errorf("Concat(%v) = %#v, want empty slice", in, got)
+		}
+	}
+}
+
+// TestRepeatFill verifies every element is set
+func TestRepeatFill(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 7, 8, 100} {
+		got := Repeat("x", n)
+		if len(got) != n {
+			t.Fatalf("Repeat(%d) length = %d", n, len(got))
+		}
+		for i, v := range got {
+			if v != "x" {
+				t.Fatalf("Repeat(%d)[%d] = %q, want %q", n, i, v, "x")
+			}
+		}
+	}
+
+	for _, n := range []int{0, -1} {
+		if got := Repeat(1, n); got == nil || len(got) != 0 {
+			t.Errorf("Repeat(%d) = %#v, want empty slice", n, got)
+		}
+	}
+
+	buf := []int{1, 2, 3, 4, 5}
+	Fill(buf[1:4], 0)
+	if fmt.Sprint(buf) != "[1 0 0 0 5]" {
+		t.Errorf("Fill() = %v, want [1 0 0 0 5]", buf)
+	}
+	Fill([]int(nil), 1)
+}
+
+func BenchmarkFill(b *testing.B) {
+	buf := make([]int64, 1<<16)
+
+	b.Run("Fill", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Fill(buf, int64(i))
+		}
+	})
+
+	b.Run("Loop", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for j := range buf {
+				buf[j] = int64(i)
+			}
+		}
+	})
+}
+
+// TestFlatten verifies chunks are recombined into a fresh allocation
+func TestFlatten(t *testing.T) {
+	src := []int{1, 2, 3, 4, 5}
+	chunks := [][]int{src[0:2], nil, src[2:5]}
+
+	got := Flatten(chunks)
+	if fmt.Sprint(got) != "[1 2 3 4 5]" {
+		t.Fatalf("Flatten() = %v, want [1 2 3 4 5]", got)
+	}
+
+	got[0] = 100
+	if src[0] != 1 {
+		t.Errorf("Flatten() result aliases the input: %v", src)
+	}
+
+	if got := Flatten([][]int{{}, nil}); got == nil || len(got) != 0 {
+		t.Errorf("Flatten() of empty chunks = %#v, want empty slice", got)
+	}
+}
+
+// TestGroupBy verifies runs of consecutive elements are grouped as views
+func TestGroupBy(t *testing.T) {
+	states := []string{"up", "up", "down", "up", "up", "up"}
+	same := func(prev, cur string) bool { return prev == cur }
+
+	got := GroupBy(states, same)
+	if fmt.Sprint(got) != "[[up up] [down] [up up up]]" {
+		t.Fatalf("GroupBy() = %v, want [[up up] [down] [up up up]]", got)
+	}
+
+	got[1][0] = "unknown"
+	if states[2] != "unknown" {
+		t.Errorf("Expected groups to share storage with the input, got %v", states)
+	}
+	_ = append(got[0], "appended")
+	if states[2] != "unknown" {
+		t.Errorf("Appending to a group overwrote the next group: %v", states)
+	}
+
+	if got := GroupBy([]int{1}, func(_, _ int) bool { return false }); len(got) != 1 || len(got[0]) != 1 {
+		t.Errorf("GroupBy() of single element = %v, want [[1]]", got)
+	}
+	if got := GroupBy(nil, same); got != nil {
+		t.Errorf("GroupBy(nil) = %v, want nil", got)
+	}
+}
+
+// TestBinarySearch verifies found indices and insertion points
+func TestBinarySearch(t *testing.T) {
+	ids := []int{10, 20, 20, 30, 40}
+
+	tests := []struct {
+		name      string
+		s         []int
+		target    int
+		wantIndex int
+		wantFound bool
+	}{
+		{name: "found", s: ids, target: 30, wantIndex: 3, wantFound: true},
+		{name: "first of duplicates", s: ids, target: 20, wantIndex: 1, wantFound: true},
+		{name: "before all", s: ids, target: 5, wantIndex: 0, wantFound: false},
+		{name: "between", s: ids, target: 25, wantIndex: 3, wantFound: false},
+		{name: "after all", s: ids, target: 50, wantIndex: 5, wantFound: false},
+		{name: "empty", s: nil, target: 1, wantIndex: 0, wantFound: false},
+		{name: "single match", s: []int{7}, target: 7, wantIndex: 0, wantFound: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index, found := BinarySearch(tt.s, tt.target)
+			if index != tt.wantIndex || found != tt.wantFound {
+				t.Errorf("BinarySearch() = (%d, %v), want (%d, %v)", index, found, tt.wantIndex, tt.wantFound)
+			}
+		})
+	}
+
+	type user struct {
+		id   int
+		name string
+	}
+	users := []user{{1, "a"}, {3, "b"}, {5, "c"}}
+	byID := func(target int) func(user) int {
+		return func(u user) int { return u.id - target }
+	}
+	if index, found := BinarySearchFunc(users, byID(3)); index != 1 || !found {
+		t.Errorf("BinarySearchFunc() = (%d, %v), want (1, true)", index, found)
+	}
+	if index, found := BinarySearchFunc(users, byID(4)); index != 2 || found {
+		t.Errorf("BinarySearchFunc() = (%d, %v), want (2, false)", index, found)
+	}
+}
+
+// TestEqual verifies element-wise comparison with nil and empty treated alike
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []int
+		want bool
+	}{
+		{name: "equal", a: []int{1, 2, 3}, b: []int{1, 2, 3}, want: true},
+		{name: "different element", a: []int{1, 2, 3}, b: []int{1, 5, 3}, want: false},
+		{name: "different length", a: []int{1, 2}, b: []int{1, 2, 3}, want: false},
+		{name: "nil and empty", a: nil, b: []int{}, want: true},
+		{name: "both nil", a: nil, b: nil, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Equal(tt.a, tt.b); got != tt.want {
+				t.Errorf("Equal() = %v, want %v", got, tt.want)
+			}
+			eq := func(x, y int) bool { return x == y }
+			if got := EqualFunc(tt.a, tt.b, eq); got != tt.want {
+				t.Errorf("EqualFunc() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	approx := func(x, y float64) bool { return math.Abs(x-y) < 1e-9 }
+	if !EqualFunc([]float64{0.1 + 0.2}, []float64{0.3}, approx) {
+		t.Errorf("EqualFunc() with tolerance = false, want true")
+	}
+}
+
+// TestKeyByToMap verifies indexing with last-write-wins on duplicate keys
+func TestKeyByToMap(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+	users := []user{{1, "a"}, {2, "b"}, {1, "c"}}
+
+	byID := KeyBy(users, func(u user) int { return u.id })
+	if len(byID) != 2 || byID[1].name != "c" || byID[2].name != "b" {
+		t.Errorf("KeyBy() = %v, want map[1:{1 c} 2:{2 b}]", byID)
+	}
+
+	names := ToMap(users, func(u user) (int, string) { return u.id, u.name })
+	if len(names) != 2 || names[1] != "c" || names[2] != "b" {
+		t.Errorf("ToMap() = %v, want map[1:c 2:b]", names)
+	}
+
+	if got := KeyBy(nil, func(u user) int { return u.id }); got == nil || len(got) != 0 {
+		t.Errorf("KeyBy(nil) = %#v, want empty map", got)
+	}
+}
+
+// TestShuffleRand verifies seeded shuffles are reproducible permutations
+func TestShuffleRand(t *testing.T) {
+	a := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	b := []int{1, 2, 3, 4, 5, 6, 7, 8}
+
+	ShuffleRand(a, rand.New(rand.NewSource(42)))
+	ShuffleRand(b, rand.New(rand.NewSource(42)))
+	if !Equal(a, b) {
+		t.Errorf("ShuffleRand() with the same seed = %v and %v, want identical", a, b)
+	}
+
+	Shuffle(a)
+	sum := 0
+	for _, v := range a {
+		sum += v
+	}
+	if len(a) != 8 || sum != 36 {
+		t.Errorf("Shuffle() = %v, want a permutation of 1..8", a)
+	}
+
+	ShuffleRand([]int(nil), rand.New(rand.NewSource(1)))
+}
+
+// TestSumMaxMin verifies the basic numeric aggregations
+func TestSumMaxMin(t *testing.T) {
+	ints := []int{3, -1, 7, 2}
+	if got := Sum(ints); got != 11 {
+		t.Errorf("Sum() = %d, want 11", got)
+	}
+	if got, ok := Max(ints); !ok || got != 7 {
+		t.Errorf("Max() = (%d, %v), want (7, true)", got, ok)
+	}
+	if got, ok := Min(ints); !ok || got != -1 {
+		t.Errorf("Min() = (%d, %v), want (-1, true)", got, ok)
+	}
+
+	if got := Sum([]float64{0.5, 1.25}); got != 1.75 {
+		t.Errorf("Sum() = %v, want 1.75", got)
+	}
+	if got, ok := Max([]string{"b", "c", "a"}); !ok || got != "c" {
+		t.Errorf("Max() = (%q, %v), want (\"c\", true)", got, ok)
+	}
+
+	if got := Sum([]int(nil)); got != 0 {
+		t.Errorf("Sum(nil) = %d, want 0", got)
+	}
+	if got, ok := Max([]int{}); ok || got != 0 {
+		t.Errorf("Max(empty) = (%d, %v), want (0, false)", got, ok)
+	}
+	if got, ok := Min([]int(nil)); ok || got != 0 {
+		t.Errorf("Min(nil) = (%d, %v), want (0, false)", got, ok)
+	}
+}
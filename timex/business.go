@@ -0,0 +1,142 @@
+package timex
+
+import (
+	"fmt"
+	"time"
+)
+
+// BusinessHoursBetween returns the total time between start and end that falls inside the
+// daily working window [dayStart, dayEnd) on days not listed in weekend.
+// dayStart and dayEnd are offsets from local midnight (e.g., 9*time.Hour and 17*time.Hour)
+// applied as wall-clock times in start's location, so DST transitions do not shift the window.
+// It returns zero if end is not after start or the window is empty.
+func BusinessHoursBetween(start, end time.Time, dayStart, dayEnd time.Duration, weekend []time.Weekday) time.Duration {
+	if !end.After(start) || dayEnd <= dayStart {
+		return 0
+	}
+
+	skip := make(map[time.Weekday]bool, len(weekend))
+	for _, wd := range weekend {
+		skip[wd] = true
+	}
+
+	loc := start.Location()
+	end = end.In(loc)
+	y, m, d := start.Date()
+
+	var total time.Duration
+	for day := time.Date(y, m, d, 0, 0, 0, 0, loc); day.Before(end); day = time.Date(y, m, d+1, 0, 0, 0, 0, loc) {
+		y, m, d = day.Date()
+		if skip[day.Weekday()] {
+			continue
+		}
+
+		// Build the window from wall-clock components so that it stays at, e.g., 09:00-17:00 on DST days.
+		windowStart := time.Date(y, m, d, 0, 0, 0, int(dayStart), loc)
+		windowEnd := time.Date(y, m, d, 0, 0, 0, int(dayEnd), loc)
+
+		from := windowStart
+		if start.After(from) {
+			from = start
+		}
+		to := windowEnd
+		if end.Before(to) {
+			to = end
+		}
+		if to.After(from) {
+			total += to.Sub(from)
+		}
+	}
+	return total
+}
+
+// AddBusinessDays moves t forward by days business days, or backward when days is negative,
+// skipping days listed in weekend and any dates listed in holidays. Holidays match by calendar
+// date, and the wall-clock time of t is preserved. It returns t unchanged when days is zero.
+// It returns an error if weekend contains a value outside Sunday..Saturday or covers all seven
+// days, since no business day could ever be reached.
+func AddBusinessDays(t time.Time, days int, weekend []time.Weekday, holidays ...time.Time) (time.Time, error) {
+	cal := newBusinessCalendar(weekend, holidays)
+	for _, wd := range weekend {
+		if wd < time.Sunday || wd > time.Saturday {
+			return time.Time{}, fmt.Errorf("invalid weekend: weekday %d out of range", wd)
+		}
+	}
+	hasWorkday := false
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		hasWorkday = hasWorkday || !cal.weekend[wd]
+	}
+	if !hasWorkday {
+		return time.Time{}, fmt.Errorf("invalid weekend: covers every day of the week")
+	}
+
+	step := 1
+	if days < 0 {
+		step, days = -1, -days
+	}
+	for days > 0 {
+		t = t.AddDate(0, 0, step)
+		if cal.isBusinessDay(t) {
+			days--
+		}
+	}
+	return t, nil
+}
+
+// BusinessDaysBetween counts the business days after start up to and including end's date,
+// skipping days listed in weekend and any dates listed in holidays. Dates are compared in
+// start's location, and weekend values outside Sunday..Saturday never match. The result is
+// negative when end is before start, so that BusinessDaysBetween(t, u, weekend) == n whenever
+// AddBusinessDays(t, n, weekend) returns u for n >= 0.
+func BusinessDaysBetween(start, end time.Time, weekend []time.Weekday, holidays ...time.Time) int {
+	cal := newBusinessCalendar(weekend, holidays)
+	sign := 1
+	if end.Before(start) {
+		start, end, sign = end, start, -1
+	}
+
+	loc := start.Location()
+	y, m, d := start.Date()
+	ey, em, ed := end.In(loc).Date()
+	last := time.Date(ey, em, ed, 0, 0, 0, 0, time.UTC)
+
+	count := 0
+	for day := time.Date(y, m, d+1, 0, 0, 0, 0, time.UTC); !day.After(last); day = day.AddDate(0, 0, 1) {
+		if cal.isBusinessDay(day) {
+			count++
+		}
+	}
+	return sign * count
+}
+
+// businessCalendar holds the non-working weekdays and holiday dates used by the business day helpers.
+type businessCalendar struct {
+	weekend  map[time.Weekday]bool
+	holidays map[time.Time]bool // keyed by civilDate
+}
+
+// newBusinessCalendar indexes weekend by weekday and holidays by calendar date.
+func newBusinessCalendar(weekend []time.Weekday, holidays []time.Time) businessCalendar {
+	cal := businessCalendar{
+		weekend:  make(map[time.Weekday]bool, len(weekend)),
+		holidays: make(map[time.Time]bool, len(holidays)),
+	}
+	for _, wd := range weekend {
+		cal.weekend[wd] = true
+	}
+	for _, h := range holidays {
+		cal.holidays[civilDate(h)] = true
+	}
+	return cal
+}
+
+// isBusinessDay reports whether t falls on a day that is neither a weekend day nor a holiday.
+func (c businessCalendar) isBusinessDay(t time.Time) bool {
+	return !c.weekend[t.Weekday()] && !c.holidays[civilDate(t)]
+}
+
+// civilDate returns t's calendar date as UTC midnight, suitable as a map key.
+func civilDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
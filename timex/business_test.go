@@ -0,0 +1,174 @@
+package timex
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBusinessHoursBetween(t *testing.T) {
+	t.Parallel()
+
+	weekend := []time.Weekday{time.Saturday, time.Sunday}
+	at := func(day, hour, minute int) time.Time {
+		// March 2024: the 11th is a Monday
+		return time.Date(2024, 3, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		start    time.Time
+		end      time.Time
+		expected time.Duration
+	}{
+		{"within one day", at(11, 10, 0), at(11, 12, 30), 150 * time.Minute},
+		{"before and after window", at(11, 6, 0), at(11, 20, 0), 8 * time.Hour},
+		{"next morning", at(11, 16, 0), at(12, 10, 0), 2 * time.Hour},
+		{"full week", at(11, 0, 0), at(18, 0, 0), 40 * time.Hour},
+		{"over weekend", at(15, 16, 0), at(18, 10, 0), 2 * time.Hour},
+		{"only weekend", at(16, 9, 0), at(17, 17, 0), 0},
+		{"end before start", at(12, 10, 0), at(11, 10, 0), 0},
+		{"outside window", at(11, 18, 0), at(12, 8, 0), 0},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := BusinessHoursBetween(tt.start, tt.end, 9*time.Hour, 17*time.Hour, weekend)
+			if got != tt.expected {
+				t.Errorf("expected: %v, got: %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestBusinessHoursBetweenDST(t *testing.T) {
+	t.Parallel()
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+
+	// DST starts on Sunday 2024-03-10; with no weekend the window is still a full 8 hours
+	start := time.Date(2024, 3, 10, 0, 0, 0, 0, loc)
+	end := time.Date(2024, 3, 11, 0, 0, 0, 0, loc)
+	if got := BusinessHoursBetween(start, end, 9*time.Hour, 17*time.Hour, nil); got != 8*time.Hour {
+		t.Errorf("expected: %v, got: %v", 8*time.Hour, got)
+	}
+}
+
+func TestAddBusinessDays(t *testing.T) {
+	t.Parallel()
+
+	weekend := []time.Weekday{time.Saturday, time.Sunday}
+	at := func(day int) time.Time {
+		// March 2024: the 11th is a Monday
+		return time.Date(2024, 3, day, 10, 30, 0, 0, time.UTC)
+	}
+	holidays := []time.Time{time.Date(2024, 3, 13, 0, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name     string
+		start    time.Time
+		days     int
+		holidays []time.Time
+		expected time.Time
+	}{
+		{"zero", at(16), 0, nil, at(16)},
+		{"within week", at(11), 3, nil, at(14)},
+		{"friday to monday", at(15), 1, nil, at(18)},
+		{"from saturday", at(16), 1, nil, at(18)},
+		{"full week", at(11), 5, nil, at(18)},
+		{"backward over weekend", at(18), -1, nil, at(15)},
+		{"backward from sunday", at(17), -2, nil, at(14)},
+		{"skips holiday", at(12), 1, holidays, at(14)},
+		{"backward skips holiday", at(14), -1, holidays, at(12)},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := AddBusinessDays(tt.start, tt.days, weekend, tt.holidays...)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !got.Equal(tt.expected) {
+				t.Errorf("expected: %v, got: %v", tt.expected, got)
+			}
+			if tt.days >= 0 {
+				if n := BusinessDaysBetween(tt.start, got, weekend, tt.holidays...); n != tt.days {
+					t.Errorf("expected round trip to %d days, got: %d", tt.days, n)
+				}
+			}
+		})
+	}
+}
+
+func TestBusinessDaysBetween(t *testing.T) {
+	t.Parallel()
+
+	weekend := []time.Weekday{time.Saturday, time.Sunday}
+	at := func(day, hour int) time.Time {
+		// March 2024: the 11th is a Monday
+		return time.Date(2024, 3, day, hour, 0, 0, 0, time.UTC)
+	}
+	holidays := []time.Time{time.Date(2024, 3, 13, 0, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name     string
+		start    time.Time
+		end      time.Time
+		holidays []time.Time
+		expected int
+	}{
+		{"same day", at(11, 9), at(11, 17), nil, 0},
+		{"next day", at(11, 17), at(12, 9), nil, 1},
+		{"full week", at(11, 9), at(18, 9), nil, 5},
+		{"weekend only", at(15, 9), at(17, 9), nil, 0},
+		{"with holiday", at(11, 9), at(15, 9), holidays, 3},
+		{"reversed", at(18, 9), at(11, 9), nil, -5},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := BusinessDaysBetween(tt.start, tt.end, weekend, tt.holidays...); got != tt.expected {
+				t.Errorf("expected: %v, got: %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestBusinessDaysCustomWeekend(t *testing.T) {
+	t.Parallel()
+
+	// A Friday/Saturday weekend; March 2024: the 14th is a Thursday
+	weekend := []time.Weekday{time.Friday, time.Saturday}
+	thursday := time.Date(2024, 3, 14, 9, 0, 0, 0, time.UTC)
+	sunday := time.Date(2024, 3, 17, 9, 0, 0, 0, time.UTC)
+
+	if got, err := AddBusinessDays(thursday, 1, weekend); err != nil || !got.Equal(sunday) {
+		t.Errorf("expected: %v, got: %v (err: %v)", sunday, got, err)
+	}
+	if got := BusinessDaysBetween(thursday, sunday, weekend); got != 1 {
+		t.Errorf("expected: 1, got: %v", got)
+	}
+	if got := BusinessDaysBetween(thursday, sunday, nil); got != 3 {
+		t.Errorf("expected: 3 with no weekend, got: %v", got)
+	}
+
+	invalid := [][]time.Weekday{
+		{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday},
+		{0, 1, 2, 3, 4, 5, 6, 7},
+		{time.Saturday, 7},
+		{-1},
+	}
+	for _, weekend := range invalid {
+		if _, err := AddBusinessDays(thursday, 1, weekend); err == nil {
+			t.Errorf("expected error for weekend %v", weekend)
+		}
+	}
+}
@@ -54,3 +54,12 @@ func IsTimeActive(start, end time.Time) bool {
 	now := time.Now()
 	return !now.Before(start) && !now.After(end)
 }
+
+// DeadlineFromNow returns the absolute deadline d from now together with a function
+// reporting the time left until that deadline, clamped at zero once it has passed.
+func DeadlineFromNow(d time.Duration) (deadline time.Time, remaining func() time.Duration) {
+	deadline = time.Now().Add(d)
+	return deadline, func() time.Duration {
+		return CalculateRemainingTime(deadline)
+	}
+}
@@ -1,6 +1,12 @@
 package timex
 
-import "time"
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
 
 // RoundDurationToMinutes rounds a duration to the nearest whole minute.
 func RoundDurationToMinutes(duration time.Duration) time.Duration {
@@ -28,3 +34,234 @@ func SplitDuration(duration time.Duration) (hours int, minutes int, seconds int)
 	seconds = totalSeconds % 60
 	return hours, minutes, seconds
 }
+
+// humanDurationUnits maps the unit words accepted by ParseDurationHuman to their length.
+var humanDurationUnits = map[string]time.Duration{
+	"ns": time.Nanosecond, "nanosecond": time.Nanosecond, "nanoseconds": time.Nanosecond,
+	"us": time.Microsecond, "µs": time.Microsecond, "microsecond": time.Microsecond, "microseconds": time.Microsecond,
+	"ms": time.Millisecond, "millisecond": time.Millisecond, "milliseconds": time.Millisecond,
+	"s": time.Second, "sec": time.Second, "secs": time.Second, "second": time.Second, "seconds": time.Second,
+	"m": time.Minute, "min": time.Minute, "mins": time.Minute, "minute": time.Minute, "minutes": time.Minute,
+	"h": time.Hour, "hr": time.Hour, "hrs": time.Hour, "hour": time.Hour, "hours": time.Hour,
+	"d": 24 * time.Hour, "day": 24 * time.Hour, "days": 24 * time.Hour,
+	"w": 7 * 24 * time.Hour, "week": 7 * 24 * time.Hour, "weeks": 7 * 24 * time.Hour,
+}
+
+// ParseDurationHuman parses a duration written either in Go syntax ("1h30m") or in natural
+// form ("90 minutes", "1.5 hours", "2 days 3 hours", "1 hour and 30 minutes").
+// Days are 24 hours and weeks are 7 days. Unit words are case-insensitive, and components may
+// be separated by spaces, commas or "and". A single leading sign applies to the whole duration.
+func ParseDurationHuman(s string) (time.Duration, error) {
+	input := strings.ToLower(strings.TrimSpace(s))
+	if input == "" {
+		return 0, fmt.Errorf("empty duration string")
+	}
+	if d, err := time.ParseDuration(input); err == nil {
+		return d, nil
+	}
+
+	rest := input
+	negative := false
+	if rest[0] == '-' || rest[0] == '+' {
+		negative = rest[0] == '-'
+		rest = rest[1:]
+	}
+
+	var total durationAccumulator
+	components := 0
+	for {
+		rest = strings.TrimLeft(rest, " \t,")
+		if strings.HasPrefix(rest, "and ") {
+			rest = strings.TrimLeft(rest[len("and "):], " \t")
+		}
+		if rest == "" {
+			break
+		}
+
+		end := strings.IndexFunc(rest, func(r rune) bool { return (r < '0' || r > '9') && r != '.' })
+		if end == 0 {
+			return 0, fmt.Errorf("invalid duration %q: expected number at %q", s, rest)
+		}
+		if end < 0 {
+			return 0, fmt.Errorf("invalid duration %q: missing unit after %q", s, rest)
+		}
+		number := rest[:end]
+
+		rest = strings.TrimLeft(rest[end:], " \t")
+		end = strings.IndexFunc(rest, func(r rune) bool { return !unicode.IsLetter(r) })
+		if end < 0 {
+			end = len(rest)
+		}
+		unit, ok := humanDurationUnits[rest[:end]]
+		if !ok {
+			return 0, fmt.Errorf("invalid duration %q: unknown unit %q", s, rest[:end])
+		}
+		rest = rest[end:]
+
+		if err := total.add(number, unit); err != nil {
+			return 0, fmt.Errorf("invalid duration %q: %w", s, err)
+		}
+		components++
+	}
+
+	if components == 0 {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+	d, err := total.duration(negative)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q: %w", s, err)
+	}
+	return d, nil
+}
+
+// errDurationOverflow reports a parsed duration that does not fit in time.Duration.
+var errDurationOverflow = errors.New("overflows time.Duration")
+
+// durationAccumulator sums decimal duration components exactly, the way time.ParseDuration does:
+// integer parts are multiplied in integer arithmetic and only the fraction goes through float64,
+// so "2.3 hours" is exactly 2h18m rather than a nanosecond short.
+type durationAccumulator struct {
+	total uint64 // magnitude in nanoseconds, at most 1<<63
+}
+
+// add adds number units to the total, where number is a decimal such as "2", "2.3" or ".5".
+func (a *durationAccumulator) add(number string, unit time.Duration) error {
+	const limit = 1 << 63
+
+	intPart, fracPart, _ := strings.Cut(number, ".")
+	if intPart == "" && fracPart == "" {
+		return fmt.Errorf("malformed number %q", number)
+	}
+	var v uint64
+	for _, c := range []byte(intPart) {
+		if c < '0' || c > '9' {
+			return fmt.Errorf("malformed number %q", number)
+		}
+		if v > limit/10 {
+			return errDurationOverflow
+		}
+		v = v*10 + uint64(c-'0')
+	}
+	if v > limit/uint64(unit) {
+		return errDurationOverflow
+	}
+	v *= uint64(unit)
+
+	// Digits beyond what fits in f are below nanosecond resolution and are ignored.
+	var f uint64
+	scale := 1.0
+	for _, c := range []byte(fracPart) {
+		if c < '0' || c > '9' {
+			return fmt.Errorf("malformed number %q", number)
+		}
+		if f > (limit-1)/10 {
+			continue
+		}
+		f = f*10 + uint64(c-'0')
+		scale *= 10
+	}
+	v += uint64(float64(f) * (float64(unit) / scale))
+
+	if v > limit || a.total > limit-v {
+		return errDurationOverflow
+	}
+	a.total += v
+	return nil
+}
+
+// duration returns the total with the given sign. A magnitude of exactly 1<<63 only fits when negative.
+func (a *durationAccumulator) duration(negative bool) (time.Duration, error) {
+	if a.total == 1<<63 && !negative {
+		return 0, errDurationOverflow
+	}
+	d := time.Duration(a.total)
+	if negative {
+		d = -d
+	}
+	return d, nil
+}
+
+// iso8601Designator pairs an ISO-8601 duration designator with the length it stands for.
+type iso8601Designator struct {
+	designator byte
+	unit       time.Duration
+}
+
+// iso8601DateUnits and iso8601TimeUnits list the designators accepted by ParseDuration
+// before and after the "T" separator, in the order they must appear.
+var (
+	iso8601DateUnits = []iso8601Designator{{'W', 7 * 24 * time.Hour}, {'D', 24 * time.Hour}}
+	iso8601TimeUnits = []iso8601Designator{{'H', time.Hour}, {'M', time.Minute}, {'S', time.Second}}
+)
+
+// ParseDuration parses an ISO-8601 duration such as "PT1H30M", "P1DT2H" or "P2W".
+// Days are 24 hours and weeks are 7 days. The year and month designators are rejected
+// because they have no fixed length. Any component may carry a decimal fraction
+// ("PT1.5S" or "PT1,5S"), and a single leading sign applies to the whole duration.
+func ParseDuration(s string) (time.Duration, error) {
+	rest := strings.ToUpper(strings.TrimSpace(s))
+	negative := false
+	if rest != "" && (rest[0] == '-' || rest[0] == '+') {
+		negative = rest[0] == '-'
+		rest = rest[1:]
+	}
+	if !strings.HasPrefix(rest, "P") {
+		return 0, fmt.Errorf("invalid duration %q: missing P designator", s)
+	}
+
+	datePart, timePart, hasTime := strings.Cut(rest[1:], "T")
+	if hasTime && timePart == "" {
+		return 0, fmt.Errorf("invalid duration %q: T designator without time components", s)
+	}
+
+	var total durationAccumulator
+	components := 0
+	for _, part := range []struct {
+		text   string
+		units  []iso8601Designator
+		isDate bool
+	}{
+		{datePart, iso8601DateUnits, true},
+		{timePart, iso8601TimeUnits, false},
+	} {
+		text, next := part.text, 0
+		for text != "" {
+			end := strings.IndexFunc(text, func(r rune) bool { return (r < '0' || r > '9') && r != '.' && r != ',' })
+			if end == 0 {
+				return 0, fmt.Errorf("invalid duration %q: expected number at %q", s, text)
+			}
+			if end < 0 {
+				return 0, fmt.Errorf("invalid duration %q: missing designator after %q", s, text)
+			}
+			number := strings.Replace(text[:end], ",", ".", 1)
+
+			designator := text[end]
+			if designator == 'Y' || (part.isDate && designator == 'M') {
+				return 0, fmt.Errorf("invalid duration %q: year and month designators are not supported", s)
+			}
+			i := next
+			for i < len(part.units) && part.units[i].designator != designator {
+				i++
+			}
+			if i == len(part.units) {
+				return 0, fmt.Errorf("invalid duration %q: unexpected designator %q", s, designator)
+			}
+			next = i + 1
+
+			if err := total.add(number, part.units[i].unit); err != nil {
+				return 0, fmt.Errorf("invalid duration %q: %w", s, err)
+			}
+			components++
+			text = text[end+1:]
+		}
+	}
+
+	if components == 0 {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+	d, err := total.duration(negative)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q: %w", s, err)
+	}
+	return d, nil
+}
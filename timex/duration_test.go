@@ -0,0 +1,104 @@
+package timex
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestParseDurationHuman(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		value    string
+		expected time.Duration
+		hasError bool
+	}{
+		{"1h30m", 90 * time.Minute, false},
+		{"90 minutes", 90 * time.Minute, false},
+		{"1.5 hours", 90 * time.Minute, false},
+		{"2.3 hours", 2*time.Hour + 18*time.Minute, false},
+		{"1.15 hours", time.Hour + 9*time.Minute, false},
+		{".5 days", 12 * time.Hour, false},
+		{"0.000000001 seconds", time.Nanosecond, false},
+		{"2 days", 48 * time.Hour, false},
+		{"1 week 2d", 9 * 24 * time.Hour, false},
+		{"1 hour and 30 minutes", 90 * time.Minute, false},
+		{"2h, 15 mins", 135 * time.Minute, false},
+		{"3D", 72 * time.Hour, false},
+		{"-2 hours", -2 * time.Hour, false},
+		{"", 0, true},
+		{"hours", 0, true},
+		{"10", 0, true},
+		{"5 fortnights", 0, true},
+		{"1.2.3 hours", 0, true},
+		{"1000000 weeks", 0, true},
+		{"9223372036.854775808 seconds", 0, true},
+		{"-9223372036.854775808 seconds", math.MinInt64, false},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.value, func(t *testing.T) {
+			t.Parallel()
+			result, err := ParseDurationHuman(tt.value)
+			if (err != nil) != tt.hasError {
+				t.Errorf("expected error: %v, got: %v", tt.hasError, err)
+			}
+			if !tt.hasError && result != tt.expected {
+				t.Errorf("expected: %v, got: %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		value    string
+		expected time.Duration
+		hasError bool
+	}{
+		{"PT1H30M", 90 * time.Minute, false},
+		{"P1DT2H", 26 * time.Hour, false},
+		{"P2W", 14 * 24 * time.Hour, false},
+		{"P1D", 24 * time.Hour, false},
+		{"PT45S", 45 * time.Second, false},
+		{"PT1.5S", 1500 * time.Millisecond, false},
+		{"PT0,5H", 30 * time.Minute, false},
+		{"PT2.3H", 2*time.Hour + 18*time.Minute, false},
+		{"P0.5DT1.15H", 13*time.Hour + 9*time.Minute, false},
+		{"pt10m", 10 * time.Minute, false},
+		{"-PT2H", -2 * time.Hour, false},
+		{"", 0, true},
+		{"P", 0, true},
+		{"PT", 0, true},
+		{"P1DT", 0, true},
+		{"1H", 0, true},
+		{"P1H", 0, true},
+		{"PT1D", 0, true},
+		{"PT30M1H", 0, true},
+		{"PT1H1H", 0, true},
+		{"P1Y", 0, true},
+		{"P1M", 0, true},
+		{"PT1", 0, true},
+		{"P1000000W", 0, true},
+		{"PT2562047.7880152155H", 9223372036854775800, false},
+		{"PT2562047.7880152156H", 0, true},
+		{"PT9223372036.854775808S", 0, true},
+		{"-PT9223372036.854775808S", math.MinInt64, false},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.value, func(t *testing.T) {
+			t.Parallel()
+			result, err := ParseDuration(tt.value)
+			if (err != nil) != tt.hasError {
+				t.Errorf("expected error: %v, got: %v", tt.hasError, err)
+			}
+			if !tt.hasError && result != tt.expected {
+				t.Errorf("expected: %v, got: %v", tt.expected, result)
+			}
+		})
+	}
+}
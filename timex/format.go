@@ -29,3 +29,59 @@ func FormatCoolDown(duration time.Duration) string {
 		return fmt.Sprintf("%dh%dm", hours, minutes)
 	}
 }
+
+// humanizeUnits lists the buckets used by Humanize, from largest to smallest.
+// Months and years are approximated as 30 and 365 days.
+var humanizeUnits = []struct {
+	size time.Duration
+	name string
+}{
+	{365 * 24 * time.Hour, "year"},
+	{30 * 24 * time.Hour, "month"},
+	{7 * 24 * time.Hour, "week"},
+	{24 * time.Hour, "day"},
+	{time.Hour, "hour"},
+	{time.Minute, "minute"},
+}
+
+// Humanize formats a time.Duration coarsely using its largest whole unit, such as
+// "5 minutes", "2 hours" or "3 days". Durations under a minute render as "just now".
+// The sign of the duration is ignored.
+func Humanize(duration time.Duration) string {
+	// Take the magnitude as uint64 so that math.MinInt64 does not overflow on negation
+	magnitude := uint64(duration)
+	if duration < 0 {
+		magnitude = -magnitude
+	}
+	for _, unit := range humanizeUnits {
+		if magnitude < uint64(unit.size) {
+			continue
+		}
+		n := magnitude / uint64(unit.size)
+		if n == 1 {
+			return "1 " + unit.name
+		}
+		return fmt.Sprintf("%d %ss", n, unit.name)
+	}
+	return "just now"
+}
+
+// HumanizeSince describes how long ago t was relative to the current time, such as "2 hours ago".
+func HumanizeSince(t time.Time) string {
+	return HumanizeSinceAt(t, time.Now())
+}
+
+// HumanizeSinceAt describes t relative to the reference time now.
+// Past times render as "5 minutes ago", future times as "in 5 minutes",
+// and anything within a minute of now as "just now".
+func HumanizeSinceAt(t, now time.Time) string {
+	d := now.Sub(t)
+	switch {
+	case d > -time.Minute && d < time.Minute:
+		return "just now"
+	case d > 0:
+		return Humanize(d) + " ago"
+	default:
+		return "in " + Humanize(d)
+	}
+}
@@ -0,0 +1,69 @@
+package timex
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestHumanize(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		duration time.Duration
+		expected string
+	}{
+		{0, "just now"},
+		{59 * time.Second, "just now"},
+		{time.Minute, "1 minute"},
+		{5*time.Minute + 59*time.Second, "5 minutes"},
+		{2*time.Hour + 5*time.Minute, "2 hours"},
+		{-3 * time.Hour, "3 hours"},
+		{24 * time.Hour, "1 day"},
+		{3 * 24 * time.Hour, "3 days"},
+		{15 * 24 * time.Hour, "2 weeks"},
+		{60 * 24 * time.Hour, "2 months"},
+		{800 * 24 * time.Hour, "2 years"},
+		{math.MaxInt64, "292 years"},
+		{math.MinInt64, "292 years"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.expected, func(t *testing.T) {
+			t.Parallel()
+			if result := Humanize(tt.duration); result != tt.expected {
+				t.Errorf("expected: %v, got: %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestHumanizeSinceAt(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		t        time.Time
+		expected string
+	}{
+		{now, "just now"},
+		{now.Add(-30 * time.Second), "just now"},
+		{now.Add(30 * time.Second), "just now"},
+		{now.Add(-2*time.Hour - 5*time.Minute), "2 hours ago"},
+		{now.Add(-24 * time.Hour), "1 day ago"},
+		{now.Add(10 * time.Minute), "in 10 minutes"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.expected, func(t *testing.T) {
+			t.Parallel()
+			if result := HumanizeSinceAt(tt.t, now); result != tt.expected {
+				t.Errorf("expected: %v, got: %v", tt.expected, result)
+			}
+		})
+	}
+
+	if result := HumanizeSince(time.Now().Add(-3 * time.Hour)); result != "3 hours ago" {
+		t.Errorf("expected: 3 hours ago, got: %v", result)
+	}
+}
@@ -0,0 +1,58 @@
+package timex
+
+import "time"
+
+// Stopwatch measures elapsed time using the monotonic clock.
+// The zero value is a stopped stopwatch reading zero. A Stopwatch is not safe for concurrent use.
+type Stopwatch struct {
+	start   time.Time     // when the current run began
+	elapsed time.Duration // time accumulated by completed runs
+	lapMark time.Duration // elapsed reading at the previous lap
+	running bool
+}
+
+// Start starts or resumes the stopwatch. It has no effect if the stopwatch is already running.
+func (s *Stopwatch) Start() {
+	if s.running {
+		return
+	}
+	s.start = time.Now()
+	s.running = true
+}
+
+// Stop pauses the stopwatch, keeping the time accumulated so far.
+// It has no effect if the stopwatch is not running.
+func (s *Stopwatch) Stop() {
+	if !s.running {
+		return
+	}
+	s.elapsed += time.Since(s.start)
+	s.running = false
+}
+
+// Elapsed returns the total time the stopwatch has been running, including the current run.
+func (s *Stopwatch) Elapsed() time.Duration {
+	if s.running {
+		return s.elapsed + time.Since(s.start)
+	}
+	return s.elapsed
+}
+
+// Running reports whether the stopwatch is currently running.
+func (s *Stopwatch) Running() bool {
+	return s.running
+}
+
+// Lap records a split and returns the running time since the previous lap, or since the
+// stopwatch was started if this is the first lap. It does not allocate.
+func (s *Stopwatch) Lap() time.Duration {
+	now := s.Elapsed()
+	split := now - s.lapMark
+	s.lapMark = now
+	return split
+}
+
+// Reset stops the stopwatch and clears the elapsed time and lap mark.
+func (s *Stopwatch) Reset() {
+	*s = Stopwatch{}
+}
@@ -0,0 +1,60 @@
+package timex
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStopwatch(t *testing.T) {
+	t.Parallel()
+
+	var sw Stopwatch
+	if sw.Elapsed() != 0 || sw.Running() {
+		t.Fatalf("expected zero stopped stopwatch, got: %v running=%v", sw.Elapsed(), sw.Running())
+	}
+
+	sw.Start()
+	time.Sleep(10 * time.Millisecond)
+	if !sw.Running() || sw.Elapsed() < 10*time.Millisecond {
+		t.Errorf("expected at least 10ms while running, got: %v", sw.Elapsed())
+	}
+
+	first := sw.Lap()
+	time.Sleep(5 * time.Millisecond)
+	second := sw.Lap()
+	if first < 10*time.Millisecond || second < 5*time.Millisecond {
+		t.Errorf("expected laps of at least 10ms and 5ms, got: %v and %v", first, second)
+	}
+
+	sw.Stop()
+	stopped := sw.Elapsed()
+	if stopped < first+second {
+		t.Errorf("expected elapsed to cover both laps, got: %v", stopped)
+	}
+	time.Sleep(5 * time.Millisecond)
+	if sw.Elapsed() != stopped {
+		t.Errorf("expected elapsed to stay at %v while stopped, got: %v", stopped, sw.Elapsed())
+	}
+	if lap := sw.Lap(); lap != stopped-first-second {
+		t.Errorf("expected: %v, got: %v", stopped-first-second, lap)
+	}
+
+	sw.Start()
+	time.Sleep(5 * time.Millisecond)
+	if sw.Elapsed() < stopped+5*time.Millisecond {
+		t.Errorf("expected resumed elapsed beyond %v, got: %v", stopped, sw.Elapsed())
+	}
+
+	sw.Reset()
+	if sw.Elapsed() != 0 || sw.Running() || sw.Lap() != 0 {
+		t.Errorf("expected reset stopwatch to read zero, got: %v running=%v", sw.Elapsed(), sw.Running())
+	}
+}
+
+func TestStopwatchLapAllocs(t *testing.T) {
+	var sw Stopwatch
+	sw.Start()
+	if allocs := testing.AllocsPerRun(100, func() { sw.Lap() }); allocs != 0 {
+		t.Errorf("expected: 0 allocations, got: %v", allocs)
+	}
+}
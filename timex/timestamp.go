@@ -3,15 +3,17 @@ package timex
 import (
 	"fmt"
 	"math"
+	"strconv"
+	"sync/atomic"
 	"time"
 )
 
 const (
-	// Timestamp format digit lengths
-	unixSecondsDigits = 10 // 1683729075
-	unixMillisDigits  = 13 // 1683729075000
-	unixMicrosDigits  = 16 // 1683729075000000
-	unixNanosDigits   = 19 // 1683729075000000000
+	// Exclusive upper bounds on a timestamp's magnitude for each precision during auto-detection
+	// Anything at or above unixMicrosLimit is treated as nanoseconds, which int64 bounds at 19 digits
+	unixSecondsLimit = 1e10 // up to 10 digits: 1683729075
+	unixMillisLimit  = 1e13 // up to 13 digits: 1683729075000
+	unixMicrosLimit  = 1e16 // up to 16 digits: 1683729075000000
 )
 
 // TimeFormat defines different time formats
@@ -58,38 +60,47 @@ type DateValue interface {
 
 // ParseTimeWithFormat converts different types of date values to time.Time using the system timezone
 func ParseTimeWithFormat[T DateValue](value T, format TimeFormat) (time.Time, error) {
+	return ParseTimeWithFormatInLocation(value, format, time.Local)
+}
+
+// ParseTimeWithFormatInLocation converts different types of date values to time.Time in the given location
+// Strings without zone information are interpreted in loc, and timestamps are returned in loc
+func ParseTimeWithFormatInLocation[T DateValue](value T, format TimeFormat, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		return time.Time{}, fmt.Errorf("nil location")
+	}
 	switch v := any(value).(type) {
 	case string:
-		return parseStringTime(v, format)
+		return parseStringTime(v, format, loc)
 	case int64, int32, uint32, uint64:
-		return parseIntTime(v, format)
+		return parseIntTime(v, format, loc)
 	case float64:
-		return parseFloatTime(v, format)
+		return parseFloatTime(v, format, loc)
 	default:
 		return time.Time{}, fmt.Errorf("unsupported type: %T", value)
 	}
 }
 
-// parseStringTime parses a string time value using the system timezone
-func parseStringTime(value string, format TimeFormat) (time.Time, error) {
+// parseStringTime parses a string time value in the given location
+func parseStringTime(value string, format TimeFormat, loc *time.Location) (time.Time, error) {
 	if value == "" {
 		return time.Time{}, fmt.Errorf("empty time string")
 	}
 
 	// If no format is specified, try to auto-detect
 	if format == "" {
-		return parseAutoDetectFormat(value)
+		return parseAutoDetectFormat(value, loc)
 	}
 
-	// Parse using the specified format and system timezone
-	t, err := time.ParseInLocation(string(format), value, time.Local)
+	// Parse using the specified format and location
+	t, err := time.ParseInLocation(string(format), value, loc)
 	if err != nil {
 		return time.Time{}, fmt.Errorf("parse time error: %w", err)
 	}
 	return t, nil
 }
 
-func parseIntTime(value interface{}, format TimeFormat) (time.Time, error) {
+func parseIntTime(value interface{}, format TimeFormat, loc *time.Location) (time.Time, error) {
 	var timestamp int64
 	switch v := value.(type) {
 	case int64:
@@ -99,6 +110,9 @@ func parseIntTime(value interface{}, format TimeFormat) (time.Time, error) {
 	case uint32:
 		timestamp = int64(v)
 	case uint64:
+		if v > math.MaxInt64 {
+			return time.Time{}, fmt.Errorf("timestamp %d exceeds nanosecond precision", v)
+		}
 		//nolint:gosec
 		timestamp = int64(v)
 	default:
@@ -121,33 +135,34 @@ func parseIntTime(value interface{}, format TimeFormat) (time.Time, error) {
 			// Default to seconds
 			t = time.Unix(timestamp, 0)
 		}
-		return t.In(time.Local), nil
+		return t.In(loc), nil
 	}
 
-	// Auto-detect format based on number of digits
-	digits := int(math.Log10(float64(timestamp))) + 1
+	// Auto-detect precision from the magnitude; zero is the epoch and negative values are pre-epoch times
+	magnitude := uint64(timestamp)
+	if timestamp < 0 {
+		magnitude = -magnitude // Two's complement negation also handles math.MinInt64
+	}
 	var t time.Time
 
 	switch {
-	case digits <= unixSecondsDigits:
+	case magnitude < unixSecondsLimit:
 		t = time.Unix(timestamp, 0)
-	case digits <= unixMillisDigits:
+	case magnitude < unixMillisLimit:
 		t = time.UnixMilli(timestamp)
-	case digits <= unixMicrosDigits:
+	case magnitude < unixMicrosLimit:
 		t = time.UnixMicro(timestamp)
-	case digits <= unixNanosDigits:
-		t = time.Unix(0, timestamp)
 	default:
-		return time.Time{}, fmt.Errorf("timestamp digit count %d exceeds nanosecond precision", digits)
+		t = time.Unix(0, timestamp)
 	}
 
-	return t.In(time.Local), nil
+	return t.In(loc), nil
 }
 
-// parseFloatTime parses a floating-point timestamp and converts it to the system timezone
+// parseFloatTime parses a floating-point timestamp and converts it to the given location
 //
 //nolint:exhaustive
-func parseFloatTime(value float64, format TimeFormat) (time.Time, error) {
+func parseFloatTime(value float64, format TimeFormat, loc *time.Location) (time.Time, error) {
 	var t time.Time
 	switch format {
 	case FormatUnix:
@@ -166,40 +181,108 @@ func parseFloatTime(value float64, format TimeFormat) (time.Time, error) {
 		nsec := int64((value - float64(sec)) * 1e9)
 		t = time.Unix(sec, nsec)
 	}
-	return t.In(time.Local), nil
-}
-
-// parseAutoDetectFormat auto-detects the time format using the system timezone
-func parseAutoDetectFormat(value string) (time.Time, error) {
-	formats := []TimeFormat{
-		FormatDateTime, // Most common format first
-		FormatDate,
-		FormatTime,
-		FormatRFC3339,
-		FormatRFC3339Nano,
-		FormatANSIC,
-		FormatUnixDate,
-		FormatRubyDate,
-		FormatRFC822,
-		FormatRFC822Z,
-		FormatRFC850,
-		FormatRFC1123,
-		FormatRFC1123Z,
-		FormatStamp,
-		FormatStampMilli,
-		FormatStampMicro,
-		FormatStampNano,
-		FormatDateSlash,
-		FormatDateChinese,
+	return t.In(loc), nil
+}
+
+// autoDetectFormats lists the layouts tried by parseAutoDetectFormat, most common first
+var autoDetectFormats = []TimeFormat{
+	FormatDateTime, // Most common format first
+	FormatDate,
+	FormatTime,
+	FormatRFC3339,
+	FormatRFC3339Nano,
+	FormatANSIC,
+	FormatUnixDate,
+	FormatRubyDate,
+	FormatRFC822,
+	FormatRFC822Z,
+	FormatRFC850,
+	FormatRFC1123,
+	FormatRFC1123Z,
+	FormatStamp,
+	FormatStampMilli,
+	FormatStampMicro,
+	FormatStampNano,
+	FormatDateSlash,
+	FormatDateChinese,
+}
+
+// parseAutoDetectFormat auto-detects the time format in the given location
+// It keeps no state between calls; use a Detector to remember the last matching layout
+func parseAutoDetectFormat(value string, loc *time.Location) (time.Time, error) {
+	t, _, err := detectFormat(value, loc, 0)
+	return t, err
+}
+
+// detectFormat tries the layout at index hint first, then the remaining auto-detect layouts in order
+// Returns the parsed time and the index of the layout that matched
+func detectFormat(value string, loc *time.Location, hint int) (time.Time, int, error) {
+	if t, err := time.ParseInLocation(string(autoDetectFormats[hint]), value, loc); err == nil {
+		return t, hint, nil
 	}
 
-	for _, format := range formats {
-		if t, err := time.ParseInLocation(string(format), value, time.Local); err == nil {
-			return t, nil
+	for i, format := range autoDetectFormats {
+		if i == hint {
+			continue
+		}
+		if t, err := time.ParseInLocation(string(format), value, loc); err == nil {
+			return t, i, nil
 		}
 	}
 
-	return time.Time{}, fmt.Errorf("unable to detect time format for: %s", value)
+	return time.Time{}, hint, fmt.Errorf("unable to detect time format for: %s", value)
+}
+
+// Detector auto-detects string time layouts like ParseTimeWithFormat with an empty format,
+// but remembers the layout that last succeeded and tries it first on the next call
+// Input from one source usually arrives in a consistent format, so keep one Detector per source
+// The zero value is ready to use and a Detector is safe for concurrent use
+type Detector struct {
+	hint atomic.Int32 // index into autoDetectFormats
+}
+
+// Parse auto-detects the layout of value and parses it using the system timezone
+func (d *Detector) Parse(value string) (time.Time, error) {
+	return d.ParseInLocation(value, time.Local)
+}
+
+// ParseInLocation auto-detects the layout of value and parses it in the given location
+func (d *Detector) ParseInLocation(value string, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		return time.Time{}, fmt.Errorf("nil location")
+	}
+	hint := int(d.hint.Load())
+	t, matched, err := detectFormat(value, loc, hint)
+	if err == nil && matched != hint {
+		//nolint:gosec
+		d.hint.Store(int32(matched))
+	}
+	return t, err
+}
+
+// FormatTimeWithFormat formats t in the given format, mirroring ParseTimeWithFormat
+// The unix pseudo-formats emit the decimal timestamp at their precision, other formats delegate to t.Format
+// An empty format uses the first auto-detect layout, so the result parses back with an empty format too
+// Returns an error if t cannot be represented as a nanosecond timestamp for FormatUnixNano
+// It is not named FormatTime because that identifier is the time-only TimeFormat constant
+func FormatTimeWithFormat(t time.Time, format TimeFormat) (string, error) {
+	switch format {
+	case FormatUnix:
+		return strconv.FormatInt(t.Unix(), 10), nil
+	case FormatUnixMilli:
+		return strconv.FormatInt(t.UnixMilli(), 10), nil
+	case FormatUnixMicro:
+		return strconv.FormatInt(t.UnixMicro(), 10), nil
+	case FormatUnixNano:
+		if t.Before(time.Unix(0, math.MinInt64)) || t.After(time.Unix(0, math.MaxInt64)) {
+			return "", fmt.Errorf("time %v out of range for nanosecond timestamp", t)
+		}
+		return strconv.FormatInt(t.UnixNano(), 10), nil
+	case "":
+		return t.Format(string(autoDetectFormats[0])), nil
+	default:
+		return t.Format(string(format)), nil
+	}
 }
 
 // GetCurrentMilliTimestamp returns the current timestamp in milliseconds
@@ -1,6 +1,8 @@
 package timex
 
 import (
+	"math"
+	"strconv"
 	"testing"
 	"time"
 )
@@ -72,6 +74,45 @@ func TestParseTimeWithFormat_Int(t *testing.T) {
 	}
 }
 
+func TestParseTimeWithFormat_IntAutoDetect(t *testing.T) {
+	t.Parallel()
+
+	recent := time.Date(2023, 10, 1, 12, 0, 0, 0, time.Local)
+	tests := []struct {
+		name     string
+		value    int64
+		expected time.Time
+	}{
+		{"zero is epoch", 0, time.Unix(0, 0)},
+		{"negative seconds", -86400, time.Unix(-86400, 0)},
+		{"nine digit seconds", 123456789, time.Unix(123456789, 0)},
+		{"ten digit seconds", recent.Unix(), recent},
+		{"millis", recent.UnixMilli(), recent},
+		{"negative millis", -86400000000, time.UnixMilli(-86400000000)},
+		{"micros", recent.UnixMicro(), recent},
+		{"nanos", recent.UnixNano(), recent},
+		{"min int64", math.MinInt64, time.Unix(0, math.MinInt64)},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			result, err := ParseTimeWithFormat(tt.value, "")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !result.Equal(tt.expected) {
+				t.Errorf("expected: %v, got: %v", tt.expected, result)
+			}
+		})
+	}
+
+	if _, err := ParseTimeWithFormat(uint64(math.MaxUint64), ""); err == nil {
+		t.Errorf("expected error for uint64 beyond int64 range")
+	}
+}
+
 func TestParseTimeWithFormat_Float(t *testing.T) {
 	t.Parallel()
 
@@ -138,3 +179,153 @@ func TestParseTimeWithFormat_AutoDetect(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatTimeWithFormat_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2023, 10, 1, 12, 34, 56, 0, time.Local)
+	tests := []struct {
+		format   TimeFormat
+		expected string
+	}{
+		{FormatUnix, strconv.FormatInt(base.Unix(), 10)},
+		{FormatUnixMilli, strconv.FormatInt(base.UnixMilli(), 10)},
+		{FormatUnixMicro, strconv.FormatInt(base.UnixMicro(), 10)},
+		{FormatUnixNano, strconv.FormatInt(base.UnixNano(), 10)},
+		{FormatDateTime, "2023-10-01 12:34:56"},
+		{"", "2023-10-01 12:34:56"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(string(tt.format), func(t *testing.T) {
+			t.Parallel()
+			result, err := FormatTimeWithFormat(base, tt.format)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("expected: %v, got: %v", tt.expected, result)
+			}
+
+			// Numeric formats parse back from their integer value, layouts from the string
+			var parsed time.Time
+			if n, convErr := strconv.ParseInt(result, 10, 64); convErr == nil {
+				parsed, err = ParseTimeWithFormat(n, tt.format)
+			} else {
+				parsed, err = ParseTimeWithFormat(result, tt.format)
+			}
+			if err != nil || !parsed.Equal(base) {
+				t.Errorf("expected round trip to %v, got: %v (err: %v)", base, parsed, err)
+			}
+		})
+	}
+
+	if _, err := FormatTimeWithFormat(time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC), FormatUnixNano); err == nil {
+		t.Errorf("expected error for time outside nanosecond range")
+	}
+}
+
+func TestDetector(t *testing.T) {
+	t.Parallel()
+
+	// Alternate between layouts so the remembered hint is both hit and missed
+	values := []struct {
+		value    string
+		expected time.Time
+	}{
+		{"2023/10/01", time.Date(2023, 10, 1, 0, 0, 0, 0, time.Local)},
+		{"2023/10/02", time.Date(2023, 10, 2, 0, 0, 0, 0, time.Local)},
+		{"2023-10-01 12:34:56", time.Date(2023, 10, 1, 12, 34, 56, 0, time.Local)},
+		{"2023/10/03", time.Date(2023, 10, 3, 0, 0, 0, 0, time.Local)},
+	}
+
+	var d Detector
+	for _, tt := range values {
+		result, err := d.Parse(tt.value)
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %v", tt.value, err)
+		}
+		if !result.Equal(tt.expected) {
+			t.Errorf("expected: %v, got: %v", tt.expected, result)
+		}
+	}
+	if got := autoDetectFormats[d.hint.Load()]; got != FormatDateSlash {
+		t.Errorf("expected hint: %v, got: %v", FormatDateSlash, got)
+	}
+
+	utc, err := d.ParseInLocation("2023/10/01", time.UTC)
+	if err != nil || !utc.Equal(time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected: %v, got: %v (err: %v)", time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC), utc, err)
+	}
+	if _, err := d.Parse("invalid"); err == nil {
+		t.Errorf("expected error for invalid value")
+	}
+	if _, err := d.ParseInLocation("2023/10/01", nil); err == nil {
+		t.Errorf("expected error for nil location")
+	}
+}
+
+func TestParseTimeWithFormatInLocation(t *testing.T) {
+	t.Parallel()
+
+	shanghai := time.FixedZone("CST", 8*3600)
+	unixTime := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		parse    func() (time.Time, error)
+		loc      *time.Location
+		expected time.Time
+	}{
+		{"string UTC", func() (time.Time, error) {
+			return ParseTimeWithFormatInLocation("2023-10-01 12:34:56", FormatDateTime, time.UTC)
+		}, time.UTC, time.Date(2023, 10, 1, 12, 34, 56, 0, time.UTC)},
+		{"string fixed zone", func() (time.Time, error) {
+			return ParseTimeWithFormatInLocation("2023-10-01 12:34:56", FormatDateTime, shanghai)
+		}, shanghai, time.Date(2023, 10, 1, 12, 34, 56, 0, shanghai)},
+		{"auto-detect fixed zone", func() (time.Time, error) {
+			return ParseTimeWithFormatInLocation("2023/10/01", "", shanghai)
+		}, shanghai, time.Date(2023, 10, 1, 0, 0, 0, 0, shanghai)},
+		{"int timestamp", func() (time.Time, error) {
+			return ParseTimeWithFormatInLocation(unixTime.Unix(), FormatUnix, shanghai)
+		}, shanghai, unixTime},
+		{"float timestamp", func() (time.Time, error) {
+			return ParseTimeWithFormatInLocation(float64(unixTime.Unix()), FormatUnix, time.UTC)
+		}, time.UTC, unixTime},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			result, err := tt.parse()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !result.Equal(tt.expected) {
+				t.Errorf("expected: %v, got: %v", tt.expected, result)
+			}
+			if result.Location() != tt.loc {
+				t.Errorf("expected location: %v, got: %v", tt.loc, result.Location())
+			}
+		})
+	}
+
+	if _, err := ParseTimeWithFormatInLocation("2023-10-01", FormatDate, nil); err == nil {
+		t.Errorf("expected error for nil location")
+	}
+}
+
+func BenchmarkParseAutoDetect(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseTimeWithFormat("2023年10月01日", "")
+	}
+}
+
+func BenchmarkDetector(b *testing.B) {
+	var d Detector
+	for i := 0; i < b.N; i++ {
+		_, _ = d.Parse("2023年10月01日")
+	}
+}